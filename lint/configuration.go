@@ -9,10 +9,11 @@ import (
 )
 
 // ConfigurationFile contains a map for rule exclusions, and warnings, where the key is the
-// rule name to be excluded or downgraded to a warning
+// rule name to be excluded or downgraded to a warning, and the names of opt-in rules to enable.
 type ConfigurationFile struct {
 	Exclusions map[string]*ConfigurationRuleEntries `yaml:"exclusions"`
 	Warnings   map[string]*ConfigurationRuleEntries `yaml:"warnings"`
+	Enable     []string                             `yaml:"enable"`
 	Verbose    bool                                 `yaml:"-"`
 	Autofix    bool                                 `yaml:"-"`
 }
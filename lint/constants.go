@@ -6,7 +6,18 @@ const (
 	panelTypeStat       = "stat"
 	panelTypeSingleStat = "singlestat"
 	panelTypeGauge      = "gauge"
+	panelTypeBarGauge   = "bargauge"
 	panelTypeGraph      = "graph"
 	panelTypeTimeSeries = "timeseries"
 	panelTypeTimeTable  = "table"
+	panelTypeHeatmap    = "heatmap"
+	panelTypeRow        = "row"
+	panelTypeLogs       = "logs"
+	panelTypeText       = "text"
+)
+
+// Values of the hide property of template variables.
+const (
+	templateHideLabel    = 1
+	templateHideVariable = 2
 )
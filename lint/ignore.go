@@ -0,0 +1,187 @@
+package lint
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IgnoreFileName is the name of the file listing dashboards which LintDir and LintFiles should skip.
+const IgnoreFileName = ".lintignore"
+
+// IgnoreFile contains gitignore-style glob patterns, relative to the directory containing the ignore
+// file. Blank lines and lines starting with '#' are skipped, a leading '!' re-includes previously ignored
+// paths, unless a parent directory is ignored, and a trailing '/' only matches directories. Patterns
+// without a '/' match a file or directory name at any depth, other patterns match the path from the ignore
+// file's directory. '**' is not supported.
+type IgnoreFile struct {
+	dir      string
+	patterns []ignorePattern
+}
+
+type ignorePattern struct {
+	glob     string
+	negate   bool
+	anchored bool
+	dirOnly  bool
+}
+
+// LoadIgnoreFile reads the ignore file filename. A missing file ignores nothing.
+func LoadIgnoreFile(filename string) (*IgnoreFile, error) {
+	i := &IgnoreFile{dir: filepath.Dir(filename)}
+
+	f, err := os.Open(filename)
+	if err != nil && os.IsNotExist(err) {
+		return i, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p := ignorePattern{}
+		if strings.HasPrefix(line, "!") {
+			p.negate = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			p.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+		p.anchored = strings.Contains(line, "/")
+		p.glob = strings.TrimPrefix(line, "/")
+		i.patterns = append(i.patterns, p)
+	}
+	return i, scanner.Err()
+}
+
+// Match returns true if the file at filename should be skipped. Files outside the ignore file's
+// directory never match.
+func (i *IgnoreFile) Match(filename string) bool {
+	ignored, _ := i.match(filename)
+	return ignored
+}
+
+// match returns whether filename is ignored, and whether any pattern matched it at all, so that
+// patterns of a nested ignore file only override those of its parents when they match. As with gitignore,
+// a file in an ignored directory is ignored, and can't be re-included by a negated pattern.
+func (i *IgnoreFile) match(filename string) (ignored, matched bool) {
+	rel, err := filepath.Rel(i.dir, filename)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return false, false
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+
+	for n := 1; n <= len(parts); n++ {
+		ignored, matched = i.matchPath(parts[:n], n < len(parts))
+		if ignored {
+			return true, true
+		}
+	}
+	return ignored, matched
+}
+
+// matchPath returns whether the path made of parts is ignored by the last pattern matching it, and whether
+// any pattern matched it, without checking its parent directories.
+func (i *IgnoreFile) matchPath(parts []string, isDir bool) (ignored, matched bool) {
+	for _, p := range i.patterns {
+		if p.match(parts, isDir) {
+			ignored, matched = !p.negate, true
+		}
+	}
+	return ignored, matched
+}
+
+// ignoreFiles resolves the ignore files which apply to a dashboard file: the one in its own directory,
+// and those in every parent directory. As with gitignore, patterns in deeper ignore files take precedence.
+// Loaded ignore files are cached, so that files in the same directories are cheap to check.
+type ignoreFiles struct {
+	loaded map[string]*IgnoreFile
+}
+
+func newIgnoreFiles() *ignoreFiles {
+	return &ignoreFiles{loaded: map[string]*IgnoreFile{}}
+}
+
+// Match returns true if the file at filename should be skipped.
+func (f *ignoreFiles) Match(filename string) (bool, error) {
+	filename, err := filepath.Abs(filename)
+	if err != nil {
+		return false, err
+	}
+
+	// Collect the directories from the root down to the file's own one.
+	var dirs []string
+	for dir := filepath.Dir(filename); ; dir = filepath.Dir(dir) {
+		dirs = append([]string{dir}, dirs...)
+		if parent := filepath.Dir(dir); parent == dir {
+			break
+		}
+	}
+
+	// Check each directory below the root, and then the file itself, against the ignore files above it,
+	// root first. As with gitignore, nothing in an ignored directory can be re-included, not even by the
+	// ignore files inside it.
+	for n := 1; n <= len(dirs); n++ {
+		target, isDir := filename, false
+		if n < len(dirs) {
+			target, isDir = dirs[n], true
+		}
+		ignored := false
+		for _, dir := range dirs[:n] {
+			i, err := f.load(dir)
+			if err != nil {
+				return false, err
+			}
+			if ok, matched := i.matchPathAt(target, isDir); matched {
+				ignored = ok
+			}
+		}
+		if ignored {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *ignoreFiles) load(dir string) (*IgnoreFile, error) {
+	if i, ok := f.loaded[dir]; ok {
+		return i, nil
+	}
+	i, err := LoadIgnoreFile(filepath.Join(dir, IgnoreFileName))
+	if err != nil {
+		return nil, err
+	}
+	f.loaded[dir] = i
+	return i, nil
+}
+
+// matchPathAt is like matchPath, for filename, which may be a file or a directory. Paths outside the ignore
+// file's directory never match.
+func (i *IgnoreFile) matchPathAt(filename string, isDir bool) (ignored, matched bool) {
+	rel, err := filepath.Rel(i.dir, filename)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return false, false
+	}
+	return i.matchPath(strings.Split(filepath.ToSlash(rel), "/"), isDir)
+}
+
+// match checks the pattern against the path made of parts. Patterns ending in '/' only match directories.
+func (p ignorePattern) match(parts []string, isDir bool) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+	candidate := parts[len(parts)-1]
+	if p.anchored {
+		candidate = strings.Join(parts, "/")
+	}
+	ok, _ := path.Match(p.glob, candidate)
+	return ok
+}
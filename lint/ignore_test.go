@@ -0,0 +1,70 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnoreFile(t *testing.T) {
+	dir := t.TempDir()
+	ignore := `# vendored dashboards
+vendor/
+/generated/*.json
+*-legacy.json
+!important-legacy.json
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, IgnoreFileName), []byte(ignore), 0600))
+
+	i, err := LoadIgnoreFile(filepath.Join(dir, IgnoreFileName))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		path    string
+		ignored bool
+	}{
+		{path: "dashboard.json", ignored: false},
+		{path: "vendor/dashboard.json", ignored: true},
+		{path: "team/vendor/dashboard.json", ignored: true},
+		{path: "vendor.json", ignored: false},
+		{path: "generated/dashboard.json", ignored: true},
+		{path: "generated/nested/dashboard.json", ignored: false},
+		{path: "team/generated/dashboard.json", ignored: false},
+		{path: "team/old-legacy.json", ignored: true},
+		{path: "important-legacy.json", ignored: false},
+		{path: "../dashboard-legacy.json", ignored: false},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			require.Equal(t, tc.ignored, i.Match(filepath.Join(dir, tc.path)))
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		i, err := LoadIgnoreFile(filepath.Join(dir, "missing", IgnoreFileName))
+		require.NoError(t, err)
+		require.False(t, i.Match(filepath.Join(dir, "missing", "dashboard.json")))
+	})
+}
+
+func TestIgnoreFileNegationInIgnoredDirectory(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "generated", "nested"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, IgnoreFileName), []byte("generated/\n!generated/overview.json\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "generated", "nested", IgnoreFileName), []byte("!keep.json\n"), 0600))
+
+	// As with gitignore, files in an ignored directory can't be re-included, neither by the same ignore file
+	// nor by one inside the directory.
+	for _, path := range []string{"generated/overview.json", "generated/nested/keep.json"} {
+		t.Run(path, func(t *testing.T) {
+			i, err := LoadIgnoreFile(filepath.Join(dir, IgnoreFileName))
+			require.NoError(t, err)
+			require.True(t, i.Match(filepath.Join(dir, path)))
+
+			ignored, err := newIgnoreFiles().Match(filepath.Join(dir, path))
+			require.NoError(t, err)
+			require.True(t, ignored)
+		})
+	}
+}
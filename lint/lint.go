@@ -3,6 +3,7 @@ package lint
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 )
 
@@ -23,17 +24,20 @@ const (
 // Target is a deliberately incomplete representation of the Dashboard -> Template type in grafana.
 // The properties which are extracted from JSON are only those used for linting purposes.
 type Template struct {
-	Name       string             `json:"name"`
-	Label      string             `json:"label"`
-	Type       string             `json:"type"`
-	RawQuery   interface{}        `json:"query"`
-	Query      string             `json:"-"`
-	Datasource interface{}        `json:"datasource,omitempty"`
-	Multi      bool               `json:"multi"`
-	AllValue   string             `json:"allValue,omitempty"`
-	Current    RawTemplateValue   `json:"current"`
-	Options    []RawTemplateValue `json:"options"`
-	Refresh    int                `json:"refresh"`
+	Name        string             `json:"name"`
+	Label       string             `json:"label"`
+	Description string             `json:"description,omitempty"`
+	Type        string             `json:"type"`
+	RawQuery    interface{}        `json:"query"`
+	Query       string             `json:"-"`
+	Datasource  interface{}        `json:"datasource,omitempty"`
+	Multi       bool               `json:"multi"`
+	IncludeAll  bool               `json:"includeAll,omitempty"`
+	AllValue    string             `json:"allValue,omitempty"`
+	Current     RawTemplateValue   `json:"current"`
+	Options     []RawTemplateValue `json:"options"`
+	Refresh     int                `json:"refresh"`
+	Hide        int                `json:"hide,omitempty"`
 	// If you add properties here don't forget to add them to the raw struct, and assign them from raw to actual in UnmarshalJSON below!
 }
 
@@ -46,16 +50,19 @@ type TemplateValue struct {
 
 func (t *Template) UnmarshalJSON(buf []byte) error {
 	var raw struct {
-		Name       string             `json:"name"`
-		Label      string             `json:"label"`
-		Type       string             `json:"type"`
-		Query      interface{}        `json:"query"`
-		Datasource interface{}        `json:"datasource,omitempty"`
-		Multi      bool               `json:"multi"`
-		AllValue   string             `json:"allValue"`
-		Current    RawTemplateValue   `json:"current"`
-		Options    []RawTemplateValue `json:"options"`
-		Refresh    int                `json:"refresh"`
+		Name        string             `json:"name"`
+		Label       string             `json:"label"`
+		Description string             `json:"description"`
+		Type        string             `json:"type"`
+		Query       interface{}        `json:"query"`
+		Datasource  interface{}        `json:"datasource,omitempty"`
+		Multi       bool               `json:"multi"`
+		IncludeAll  bool               `json:"includeAll"`
+		AllValue    string             `json:"allValue"`
+		Current     RawTemplateValue   `json:"current"`
+		Options     []RawTemplateValue `json:"options"`
+		Refresh     int                `json:"refresh"`
+		Hide        int                `json:"hide"`
 	}
 	if err := json.Unmarshal(buf, &raw); err != nil {
 		return err
@@ -63,13 +70,16 @@ func (t *Template) UnmarshalJSON(buf []byte) error {
 
 	t.Name = raw.Name
 	t.Label = raw.Label
+	t.Description = raw.Description
 	t.Type = raw.Type
 	t.Datasource = raw.Datasource
 	t.Multi = raw.Multi
+	t.IncludeAll = raw.IncludeAll
 	t.AllValue = raw.AllValue
 	t.Current = raw.Current
 	t.Options = raw.Options
 	t.Refresh = raw.Refresh
+	t.Hide = raw.Hide
 	t.RawQuery = raw.Query
 
 	// the 'adhoc' and 'custom' variable type does not have a field `Query`, so we can't perform these checks
@@ -171,12 +181,16 @@ func GetDataSource(raw interface{}) (Datasource, error) {
 // Target is a deliberately incomplete representation of the Dashboard -> Panel -> Target type in grafana.
 // The properties which are extracted from JSON are only those used for linting purposes.
 type Target struct {
-	Idx        int         `json:"-"` // This is the only (best?) way to uniquely identify a target, it is set by GetPanels
-	Datasource interface{} `json:"datasource,omitempty"`
-	Expr       string      `json:"expr,omitempty"`
-	PanelId    int         `json:"panelId,omitempty"`
-	RefId      string      `json:"refId,omitempty"`
-	Hide       bool        `json:"hide"`
+	Idx          int         `json:"-"` // This is the only (best?) way to uniquely identify a target, it is set by GetPanels
+	Datasource   interface{} `json:"datasource,omitempty"`
+	Expr         string      `json:"expr,omitempty"`
+	LegendFormat string      `json:"legendFormat,omitempty"`
+	PanelId      int         `json:"panelId,omitempty"`
+	RefId        string      `json:"refId,omitempty"`
+	Hide         bool        `json:"hide"`
+	Range        bool        `json:"range,omitempty"`
+	Instant      bool        `json:"instant,omitempty"`
+	Format       string      `json:"format,omitempty"`
 }
 
 func (t *Target) GetDataSource() (Datasource, error) {
@@ -186,24 +200,98 @@ func (t *Target) GetDataSource() (Datasource, error) {
 type Annotation struct {
 	Name       string      `json:"name"`
 	Datasource interface{} `json:"datasource,omitempty"`
+	Enable     bool        `json:"enable"`
+	IconColor  string      `json:"iconColor,omitempty"`
+	// Expr is the query of older Prometheus annotations, newer ones store it in Target.
+	Expr   string  `json:"expr,omitempty"`
+	Target *Target `json:"target,omitempty"`
 }
 
 func (a *Annotation) GetDataSource() (Datasource, error) {
 	return GetDataSource(a.Datasource)
 }
 
+// GetExpr returns the query of the annotation, wherever it is stored.
+func (a *Annotation) GetExpr() string {
+	if a.Target != nil && a.Target.Expr != "" {
+		return a.Target.Expr
+	}
+	return a.Expr
+}
+
 // Panel is a deliberately incomplete representation of the Dashboard -> Panel type in grafana.
 // The properties which are extracted from JSON are only those used for linting purposes.
 type Panel struct {
-	Id          int             `json:"id"`
-	Title       string          `json:"title"`
-	Description string          `json:"description,omitempty"`
-	Targets     []Target        `json:"targets,omitempty"`
-	Datasource  interface{}     `json:"datasource,omitempty"`
-	Type        string          `json:"type"`
-	Panels      []Panel         `json:"panels,omitempty"`
-	FieldConfig *FieldConfig    `json:"fieldConfig,omitempty"`
-	Options     json.RawMessage `json:"options,omitempty"`
+	Id              int              `json:"id"`
+	Title           string           `json:"title"`
+	Description     string           `json:"description,omitempty"`
+	Targets         []Target         `json:"targets,omitempty"`
+	Datasource      interface{}      `json:"datasource,omitempty"`
+	Type            string           `json:"type"`
+	Panels          []Panel          `json:"panels,omitempty"`
+	FieldConfig     *FieldConfig     `json:"fieldConfig,omitempty"`
+	Options         json.RawMessage  `json:"options,omitempty"`
+	Transformations []Transformation `json:"transformations,omitempty"`
+	Links           []Link           `json:"links,omitempty"`
+	GridPos         *GridPos         `json:"gridPos,omitempty"`
+	PluginVersion   string           `json:"pluginVersion,omitempty"`
+	// Interval is the minimum query interval, e.g. "1m".
+	Interval      string `json:"interval,omitempty"`
+	MaxDataPoints *int   `json:"maxDataPoints,omitempty"`
+	// Repeat is the name of the variable the panel is repeated for, with RepeatDirection "h" (the default)
+	// or "v". Older Grafana versions save the copies too, with RepeatPanelId set to the id of the original.
+	Repeat          string `json:"repeat,omitempty"`
+	RepeatDirection string `json:"repeatDirection,omitempty"`
+	RepeatPanelId   int    `json:"repeatPanelId,omitempty"`
+}
+
+// GridPos is the position and size of a panel on the dashboard grid, which is 24 columns wide.
+type GridPos struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// Link is a deliberately incomplete representation of a panel link in grafana.
+// The properties which are extracted from JSON are only those used for linting purposes.
+type Link struct {
+	Title string `json:"title,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Url   string `json:"url,omitempty"`
+	// Dashboard is the uid of the linked dashboard, for links of type "dashboard".
+	Dashboard string `json:"dashboard,omitempty"`
+}
+
+var dashboardURLRegexp = regexp.MustCompile(`^(?:https?://[^/]+)?/d/([^/?#]+)`)
+
+// DashboardUID returns the uid of the dashboard a link points to, either directly for links of type
+// "dashboard", or from a /d/<uid> URL.
+func (l Link) DashboardUID() (string, bool) {
+	if l.Type == "dashboard" {
+		return l.Dashboard, true
+	}
+	if match := dashboardURLRegexp.FindStringSubmatch(l.Url); match != nil {
+		return match[1], true
+	}
+	return "", false
+}
+
+// DashboardLink is a deliberately incomplete representation of a dashboard link in grafana, shown at the top
+// of the dashboard. The properties which are extracted from JSON are only those used for linting purposes.
+type DashboardLink struct {
+	Title       string   `json:"title,omitempty"`
+	Type        string   `json:"type,omitempty"`
+	Url         string   `json:"url,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	AsDropdown  bool     `json:"asDropdown,omitempty"`
+	IncludeVars bool     `json:"includeVars,omitempty"`
+	KeepTime    bool     `json:"keepTime,omitempty"`
+}
+
+type Transformation struct {
+	Id      string          `json:"id"`
+	Options json.RawMessage `json:"options,omitempty"`
 }
 
 type FieldConfig struct {
@@ -212,9 +300,15 @@ type FieldConfig struct {
 }
 
 type Override struct {
+	Matcher            OverrideMatcher    `json:"matcher"`
 	OverrideProperties []OverrideProperty `json:"properties"`
 }
 
+type OverrideMatcher struct {
+	Id      string `json:"id"`
+	Options any    `json:"options,omitempty"`
+}
+
 type OverrideProperty struct {
 	Id    string `json:"id"`
 	Value any    `json:"value"`
@@ -232,11 +326,115 @@ type ReduceOptions struct {
 // The properties which are extracted from JSON are only those used for linting purposes.
 type StatOptions struct {
 	ReduceOptions ReduceOptions `json:"reduceOptions,omitempty"`
+	TextMode      string        `json:"textMode,omitempty"`
+	Orientation   string        `json:"orientation,omitempty"`
+	GraphMode     string        `json:"graphMode,omitempty"`
+}
+
+// TableOptions is a deliberately incomplete representation of the table panel options from grafana.
+// The properties which are extracted from JSON are only those used for linting purposes.
+type TableOptions struct {
+	Footer *TableFooter `json:"footer,omitempty"`
+}
+
+type TableFooter struct {
+	Show    bool     `json:"show,omitempty"`
+	Reducer []string `json:"reducer,omitempty"`
+	// Fields is a list of field names, but older versions of grafana store an empty string for all fields.
+	Fields json.RawMessage `json:"fields,omitempty"`
+}
+
+// FieldNames returns the names of the fields the footer is calculated for, or nil for all numeric fields.
+func (f TableFooter) FieldNames() []string {
+	var names []string
+	if err := json.Unmarshal(f.Fields, &names); err != nil {
+		return nil
+	}
+	return names
+}
+
+// LogsOptions is a deliberately incomplete representation of the logs panel options from grafana.
+// The properties which are extracted from JSON are only those used for linting purposes.
+type LogsOptions struct {
+	ShowLabels       *bool  `json:"showLabels,omitempty"`
+	ShowCommonLabels *bool  `json:"showCommonLabels,omitempty"`
+	DedupStrategy    string `json:"dedupStrategy,omitempty"`
+}
+
+// TimeseriesOptions is a deliberately incomplete representation of the timeseries panel options from grafana.
+// The properties which are extracted from JSON are only those used for linting purposes.
+type TimeseriesOptions struct {
+	Tooltip struct {
+		Mode string `json:"mode,omitempty"`
+	} `json:"tooltip,omitempty"`
+	Legend *Legend `json:"legend,omitempty"`
+}
+
+// Legend is a deliberately incomplete representation of the legend options of panels in grafana.
+// The properties which are extracted from JSON are only those used for linting purposes.
+type Legend struct {
+	ShowLegend  *bool  `json:"showLegend,omitempty"`
+	DisplayMode string `json:"displayMode,omitempty"`
+	Placement   string `json:"placement,omitempty"`
+}
+
+// HeatmapOptions is a deliberately incomplete representation of the heatmap panel options from grafana.
+// The properties which are extracted from JSON are only those used for linting purposes.
+type HeatmapOptions struct {
+	Calculate   *bool          `json:"calculate,omitempty"`
+	Calculation map[string]any `json:"calculation,omitempty"`
+	Color       struct {
+		Mode   string `json:"mode,omitempty"`
+		Scheme string `json:"scheme,omitempty"`
+	} `json:"color,omitempty"`
 }
 
 type Defaults struct {
-	Unit     string          `json:"unit,omitempty"`
-	Mappings json.RawMessage `json:"mappings,omitempty"`
+	Unit       string          `json:"unit,omitempty"`
+	Decimals   *int            `json:"decimals,omitempty"`
+	Min        *float64        `json:"min,omitempty"`
+	Max        *float64        `json:"max,omitempty"`
+	Mappings   json.RawMessage `json:"mappings,omitempty"`
+	Custom     *FieldCustom    `json:"custom,omitempty"`
+	Color      *FieldColor     `json:"color,omitempty"`
+	Thresholds *Thresholds     `json:"thresholds,omitempty"`
+}
+
+// Thresholds is a deliberately incomplete representation of the field thresholds in grafana.
+// The properties which are extracted from JSON are only those used for linting purposes.
+type Thresholds struct {
+	Mode  string          `json:"mode,omitempty"`
+	Steps []ThresholdStep `json:"steps,omitempty"`
+}
+
+// ThresholdStep is a single threshold. The first, base step has no value, and applies below all others.
+type ThresholdStep struct {
+	Color string   `json:"color,omitempty"`
+	Value *float64 `json:"value"`
+}
+
+// FieldColor is a deliberately incomplete representation of the field color options in grafana.
+// The properties which are extracted from JSON are only those used for linting purposes.
+type FieldColor struct {
+	Mode       string `json:"mode,omitempty"`
+	FixedColor string `json:"fixedColor,omitempty"`
+}
+
+// FieldCustom is a deliberately incomplete representation of the panel specific field config options in grafana.
+// The properties which are extracted from JSON are only those used for linting purposes.
+type FieldCustom struct {
+	FillOpacity   *float64  `json:"fillOpacity,omitempty"`
+	AxisPlacement string    `json:"axisPlacement,omitempty"`
+	Stacking      *Stacking `json:"stacking,omitempty"`
+	// SpanNulls is either a bool, or the number of milliseconds up to which gaps are connected.
+	SpanNulls any `json:"spanNulls,omitempty"`
+}
+
+// Stacking is a deliberately incomplete representation of the series stacking options in grafana.
+// The properties which are extracted from JSON are only those used for linting purposes.
+type Stacking struct {
+	Mode  string `json:"mode,omitempty"`
+	Group string `json:"group,omitempty"`
 }
 
 // GetPanels returns the all panels nested inside the panel (inc the current panel)
@@ -255,6 +453,7 @@ func (p *Panel) GetDataSource() (Datasource, error) {
 // Row is a deliberately incomplete representation of the Dashboard -> Row type in grafana.
 // The properties which are extracted from JSON are only those used for linting purposes.
 type Row struct {
+	Title  string  `json:"title,omitempty"`
 	Panels []Panel `json:"panels,omitempty"`
 }
 
@@ -270,17 +469,33 @@ func (r *Row) GetPanels() []Panel {
 // Dashboard is a deliberately incomplete representation of the Dashboard type in grafana.
 // The properties which are extracted from JSON are only those used for linting purposes.
 type Dashboard struct {
-	Inputs     []Input `json:"__inputs"`
-	Title      string  `json:"title,omitempty"`
+	Inputs     []Input           `json:"__inputs"`
+	Id         *int              `json:"id,omitempty"`
+	UID        string            `json:"uid,omitempty"`
+	Requires   []json.RawMessage `json:"__requires,omitempty"`
+	Title      string            `json:"title,omitempty"`
 	Templating struct {
 		List []Template `json:"list"`
 	} `json:"templating"`
 	Annotations struct {
 		List []Annotation `json:"list"`
 	} `json:"annotations"`
-	Rows     []Row   `json:"rows,omitempty"`
-	Panels   []Panel `json:"panels,omitempty"`
-	Editable bool    `json:"editable,omitempty"`
+	Rows         []Row   `json:"rows,omitempty"`
+	Panels       []Panel `json:"panels,omitempty"`
+	Editable     bool    `json:"editable,omitempty"`
+	GraphTooltip int     `json:"graphTooltip,omitempty"`
+	Timezone     string  `json:"timezone,omitempty"`
+	Version      int     `json:"version,omitempty"`
+	// Iteration is a timestamp Grafana sets when saving a dashboard, a pointer so that a missing value can be
+	// told apart from 0.
+	Iteration            *int64          `json:"iteration,omitempty"`
+	Time                 *TimeRange      `json:"time,omitempty"`
+	FiscalYearStartMonth int             `json:"fiscalYearStartMonth,omitempty"`
+	Links                []DashboardLink `json:"links,omitempty"`
+
+	// editableSet is true if the dashboard JSON sets editable, so that an unset value can be told apart from
+	// false.
+	editableSet bool
 
 	// Kubernetes shaped dashboards will include an APIVersion and Kind
 	APIVersion string `json:"apiVersion,omitempty"`
@@ -288,6 +503,12 @@ type Dashboard struct {
 	Spec json.RawMessage `json:"spec,omitempty"`
 }
 
+// TimeRange is the default time range of a dashboard, e.g. from "now-6h" to "now".
+type TimeRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
 // GetPanels returns the all panels whether they are nested in the (now deprecated) "rows" property or
 // in the top level "panels" property. This also monkeypatches Target.Idx into each panel which is used
 // to uniquely identify panel targets while linting.
@@ -323,6 +544,23 @@ func (d *Dashboard) Marshal() ([]byte, error) {
 	return json.Marshal(d)
 }
 
+// UnmarshalJSON records whether editable is set, in addition to the default unmarshalling.
+func (d *Dashboard) UnmarshalJSON(buf []byte) error {
+	type dashboard Dashboard
+	raw := struct {
+		*dashboard
+		Editable *bool `json:"editable"`
+	}{dashboard: (*dashboard)(d)}
+	if err := json.Unmarshal(buf, &raw); err != nil {
+		return err
+	}
+	if raw.Editable != nil {
+		d.Editable = *raw.Editable
+		d.editableSet = true
+	}
+	return nil
+}
+
 func NewDashboard(buf []byte) (Dashboard, error) {
 	var dash Dashboard
 	if err := json.Unmarshal(buf, &dash); err != nil {
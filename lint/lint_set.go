@@ -0,0 +1,46 @@
+package lint
+
+// LintSet is a set of dashboards which are linted together, e.g. all dashboards in a repository, so that
+// rules implementing ContextRule can resolve references between them.
+type LintSet struct {
+	dashboards []Dashboard
+	byUID      map[string]*Dashboard
+}
+
+func NewLintSet(dashboards ...Dashboard) *LintSet {
+	ls := &LintSet{
+		dashboards: dashboards,
+		byUID:      make(map[string]*Dashboard, len(dashboards)),
+	}
+	for i := range ls.dashboards {
+		if uid := ls.dashboards[i].UID; uid != "" {
+			ls.byUID[uid] = &ls.dashboards[i]
+		}
+	}
+	return ls
+}
+
+// Dashboards returns the dashboards in the set keyed by uid. Dashboards without a uid are not included.
+func (ls *LintSet) Dashboards() map[string]*Dashboard {
+	return ls.byUID
+}
+
+// Lint lints each dashboard in the set with the rules of the RuleSet. Rules implementing ContextRule are
+// passed the dashboards in the set, other rules lint each dashboard on its own.
+func (ls *LintSet) Lint(rules *RuleSet) (*ResultSet, error) {
+	resSet := &ResultSet{}
+	for _, d := range ls.dashboards {
+		ls.lintDashboard(d, rules, resSet)
+	}
+	return resSet, nil
+}
+
+func (ls *LintSet) lintDashboard(d Dashboard, rules *RuleSet, resSet *ResultSet) {
+	for _, r := range rules.rules {
+		if cr, ok := r.(ContextRule); ok {
+			cr.LintWithContext(d, ls.byUID, resSet)
+			continue
+		}
+		r.Lint(d, resSet)
+	}
+}
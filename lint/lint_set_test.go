@@ -0,0 +1,75 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintSet(t *testing.T) {
+	overview := Dashboard{
+		Title: "overview",
+		UID:   "overview",
+		Panels: []Panel{{
+			Type:  "timeseries",
+			Title: "requests",
+			Links: []Link{{Title: "Details", Url: "/d/details/service-details"}},
+		}},
+	}
+	details := Dashboard{
+		Title: "details",
+		UID:   "details",
+		Panels: []Panel{{
+			Type:  "timeseries",
+			Title: "latency",
+			Links: []Link{{Title: "Removed", Type: "dashboard", Dashboard: "removed"}},
+		}},
+	}
+	untitled := Dashboard{Title: "untitled"}
+
+	ls := NewLintSet(overview, details, untitled)
+	require.Len(t, ls.Dashboards(), 2)
+	require.Equal(t, "overview", ls.Dashboards()["overview"].Title)
+	require.Equal(t, "details", ls.Dashboards()["details"].Title)
+
+	rules := RuleSet{}
+	rules.Add(NewPanelDashboardLinkRule())
+	rules.Add(NewDashboardContextRuleFunc(
+		"test-context-rule", "Test context rule",
+		func(d Dashboard, dashboards map[string]*Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			if len(dashboards) != 2 {
+				r.AddError(d, "missing context")
+			}
+			return r
+		},
+	))
+
+	rs, err := ls.Lint(&rules)
+	require.NoError(t, err)
+
+	var actual []Result
+	for _, rc := range rs.results {
+		for _, r := range rc.Result.Results {
+			actual = append(actual, r.Result)
+		}
+	}
+	require.Equal(t, []Result{
+		ResultSuccess,
+		ResultSuccess,
+		{
+			Severity: Error,
+			Message:  "Dashboard 'details', panel 'latency' links to dashboard uid 'removed', which does not exist",
+		},
+		ResultSuccess,
+		ResultSuccess,
+	}, actual)
+
+	t.Run("without context", func(t *testing.T) {
+		rs, err := rules.Lint([]Dashboard{details})
+		require.NoError(t, err)
+		require.Len(t, rs.results, 2)
+		require.Equal(t, ResultSuccess, rs.results[0].Result.Results[0].Result)
+		require.Equal(t, Error, rs.results[1].Result.Results[0].Severity)
+	})
+}
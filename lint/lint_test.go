@@ -1,6 +1,7 @@
 package lint
 
 import (
+	"sort"
 	"strconv"
 	"testing"
 
@@ -112,6 +113,37 @@ func TestResultSet(t *testing.T) {
 		require.Len(t, byRule["rule2"], 1)
 	})
 
+	t.Run("MergeResults", func(t *testing.T) {
+		r1 := &ResultSet{
+			results: []ResultContext{
+				newResultContext("rule1", "dash1", "", "", Error),
+			},
+		}
+		r2 := &ResultSet{
+			results: []ResultContext{
+				newResultContext("rule1", "dash2", "", "", Warning),
+				newResultContext("rule2", "dash2", "", "", Success),
+			},
+		}
+
+		merged := MergeResults(r1, &ResultSet{}, nil, r2)
+
+		require.Len(t, merged.results, 3)
+		require.Equal(t, Error, merged.MaximumSeverity())
+		byRule := merged.ByRule()
+		require.Len(t, byRule["rule1"], 2)
+		require.Equal(t, "dash1", byRule["rule1"][0].Dashboard.Title)
+		require.Equal(t, "dash2", byRule["rule1"][1].Dashboard.Title)
+		require.Len(t, byRule["rule2"], 1)
+	})
+
+	t.Run("MergeResults without sets", func(t *testing.T) {
+		merged := MergeResults()
+
+		require.Empty(t, merged.results)
+		require.Equal(t, Success, merged.MaximumSeverity())
+	})
+
 	t.Run("Honors Configuration given config present before results added", func(t *testing.T) {
 		c := NewConfigurationFile()
 		appendConfigExclude(t, "rule1", "", "", "", c)
@@ -135,6 +167,84 @@ func TestResultSet(t *testing.T) {
 		require.Equal(t, Exclude, r.MaximumSeverity())
 		require.Equal(t, Exclude, r.ByRule()["rule1"][0].Result.Results[0].Severity)
 	})
+
+	t.Run("Strict", func(t *testing.T) {
+		r := ResultSet{
+			results: []ResultContext{
+				newResultContext("rule1", "", "", "", Warning),
+				newResultContext("rule2", "", "", "", Exclude),
+				newResultContext("rule3", "", "", "", Success),
+			},
+		}
+
+		strict := r.Strict()
+
+		require.Equal(t, Error, strict.MaximumSeverity())
+		byRule := strict.ByRule()
+		require.Equal(t, Error, byRule["rule1"][0].Result.Results[0].Severity)
+		require.Equal(t, Exclude, byRule["rule2"][0].Result.Results[0].Severity)
+		require.Equal(t, Success, byRule["rule3"][0].Result.Results[0].Severity)
+		// The original set is unchanged.
+		require.Equal(t, Warning, r.MaximumSeverity())
+	})
+
+	t.Run("Fingerprint", func(t *testing.T) {
+		rule := NewTargetRuleFunc("rule1", "Test Rule", func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			r.AddError(d, p, t, "is broken")
+			return r
+		})
+		rs := RuleSet{rules: []Rule{rule}}
+		fingerprints := func(d Dashboard) map[string]bool {
+			r, err := rs.Lint([]Dashboard{d})
+			require.NoError(t, err)
+			ret := map[string]bool{}
+			for _, rc := range r.results {
+				ret[rc.Fingerprint(rc.Result.Results[0].Result)] = true
+			}
+			return ret
+		}
+
+		first := Panel{Id: 1, Title: "first", Targets: []Target{{RefId: "A"}, {RefId: "B"}}}
+		second := Panel{Id: 2, Title: "second", Targets: []Target{{RefId: "A"}}}
+		before := fingerprints(Dashboard{UID: "abc", Title: "dash", Panels: []Panel{first, second}})
+		require.Len(t, before, 3)
+
+		first.Targets = []Target{first.Targets[1], first.Targets[0]}
+		after := fingerprints(Dashboard{UID: "abc", Title: "dash", Panels: []Panel{second, first}})
+		require.Equal(t, before, after)
+
+		other := fingerprints(Dashboard{UID: "def", Title: "dash", Panels: []Panel{first, second}})
+		for f := range other {
+			require.NotContains(t, before, f)
+		}
+	})
+
+	t.Run("Fingerprint ignores indexes", func(t *testing.T) {
+		rs := RuleSet{rules: []Rule{NewAnnotationConfigRule()}}
+		fingerprints := func(d Dashboard) []string {
+			r, err := rs.Lint([]Dashboard{d})
+			require.NoError(t, err)
+			var ret []string
+			for _, rc := range r.results {
+				for _, res := range rc.Result.Results {
+					ret = append(ret, rc.Fingerprint(res.Result))
+				}
+			}
+			sort.Strings(ret)
+			return ret
+		}
+
+		unnamed := Annotation{IconColor: "red"}
+		invalid := Annotation{Name: "deploys", IconColor: "not-a-color"}
+		d := Dashboard{UID: "abc", Title: "dash"}
+		d.Annotations.List = []Annotation{unnamed, invalid}
+		before := fingerprints(d)
+		require.Len(t, before, 2)
+
+		d.Annotations.List = []Annotation{invalid, {Name: "alerts"}, unnamed}
+		require.Equal(t, before, fingerprints(d))
+	})
 }
 
 func TestConfiguration(t *testing.T) {
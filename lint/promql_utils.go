@@ -0,0 +1,220 @@
+package lint
+
+import (
+	"math"
+	"strings"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// unboundedSeries is returned by estimateSeriesCount when a panel may return an arbitrary number of series.
+const unboundedSeries = math.MaxInt32
+
+// unwrapParens returns expr without any enclosing parentheses.
+func unwrapParens(expr parser.Expr) parser.Expr {
+	for {
+		paren, ok := expr.(*parser.ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = paren.Expr
+	}
+}
+
+// promQLOutputLabels returns the labels present on the series returned by expr. The second return value is
+// false when the labels can't be determined statically, e.g. because expr contains an unaggregated selector
+// which returns every label on the underlying series.
+func promQLOutputLabels(expr parser.Expr) (map[string]struct{}, bool) {
+	switch e := expr.(type) {
+	case *parser.NumberLiteral, *parser.StringLiteral:
+		return map[string]struct{}{}, true
+	case *parser.ParenExpr:
+		return promQLOutputLabels(e.Expr)
+	case *parser.UnaryExpr:
+		return promQLOutputLabels(e.Expr)
+	case *parser.StepInvariantExpr:
+		return promQLOutputLabels(e.Expr)
+	case *parser.SubqueryExpr:
+		return promQLOutputLabels(e.Expr)
+	case *parser.MatrixSelector:
+		return promQLOutputLabels(e.VectorSelector)
+	case *parser.AggregateExpr:
+		inner, known := promQLOutputLabels(e.Expr)
+		switch e.Op {
+		case parser.TOPK, parser.BOTTOMK, parser.LIMITK, parser.LIMIT_RATIO:
+			// These select series, rather than aggregating them, so keep all their labels.
+			return inner, known
+		}
+		out := map[string]struct{}{}
+		if e.Without {
+			if !known {
+				return nil, false
+			}
+			for l := range inner {
+				out[l] = struct{}{}
+			}
+			for _, l := range e.Grouping {
+				delete(out, l)
+			}
+		} else {
+			for _, l := range e.Grouping {
+				out[l] = struct{}{}
+			}
+		}
+		if s, ok := e.Param.(*parser.StringLiteral); ok && e.Op == parser.COUNT_VALUES {
+			out[s.Val] = struct{}{}
+		}
+		return out, true
+	case *parser.Call:
+		switch e.Func.Name {
+		case "label_replace", "label_join":
+			inner, known := promQLOutputLabels(e.Args[0])
+			if !known {
+				return nil, false
+			}
+			out := map[string]struct{}{}
+			for l := range inner {
+				out[l] = struct{}{}
+			}
+			if dst, ok := e.Args[1].(*parser.StringLiteral); ok {
+				out[dst.Val] = struct{}{}
+			}
+			return out, true
+		case "histogram_quantile":
+			inner, known := promQLOutputLabels(e.Args[1])
+			if !known {
+				return nil, false
+			}
+			out := map[string]struct{}{}
+			for l := range inner {
+				if l != "le" {
+					out[l] = struct{}{}
+				}
+			}
+			return out, true
+		}
+		// Most functions keep the labels of their (first) vector argument.
+		for _, arg := range e.Args {
+			if t := arg.Type(); t == parser.ValueTypeVector || t == parser.ValueTypeMatrix {
+				return promQLOutputLabels(arg)
+			}
+		}
+		return map[string]struct{}{}, true
+	case *parser.BinaryExpr:
+		lhs, lhsKnown := promQLOutputLabels(e.LHS)
+		rhs, rhsKnown := promQLOutputLabels(e.RHS)
+		if e.LHS.Type() == parser.ValueTypeScalar {
+			return rhs, rhsKnown
+		}
+		if e.RHS.Type() == parser.ValueTypeScalar {
+			return lhs, lhsKnown
+		}
+		out, known := lhs, lhsKnown
+		switch {
+		case e.Op == parser.LOR:
+			if !lhsKnown || !rhsKnown {
+				return nil, false
+			}
+			out = map[string]struct{}{}
+			for l := range lhs {
+				out[l] = struct{}{}
+			}
+			for l := range rhs {
+				out[l] = struct{}{}
+			}
+			return out, true
+		case e.VectorMatching != nil && e.VectorMatching.Card == parser.CardOneToMany:
+			out, known = rhs, rhsKnown
+		}
+		if !known {
+			return nil, false
+		}
+		if e.VectorMatching != nil && len(e.VectorMatching.Include) > 0 {
+			withIncluded := map[string]struct{}{}
+			for l := range out {
+				withIncluded[l] = struct{}{}
+			}
+			for _, l := range e.VectorMatching.Include {
+				withIncluded[l] = struct{}{}
+			}
+			out = withIncluded
+		}
+		return out, true
+	}
+	// Selectors, and anything we don't understand.
+	return nil, false
+}
+
+// isSingleSeries returns true when expr is guaranteed to return at most one series, because every label
+// has been aggregated away.
+func isSingleSeries(expr parser.Expr) bool {
+	out, known := promQLOutputLabels(expr)
+	return known && len(out) == 0
+}
+
+// estimateSeriesCount returns a best-effort estimate of the number of series returned by a panel's visible
+// targets. Targets which aggregate every label away count as a single series, whereas any other PromQL
+// target makes the estimate unboundedSeries. Targets which can't be parsed as PromQL count as one series.
+func estimateSeriesCount(d Dashboard, p Panel) int {
+	count := 0
+	for _, t := range p.Targets {
+		if t.Hide || t.Expr == "" {
+			continue
+		}
+		expr, err := parsePromQL(t.Expr, d.Templating.List)
+		if err == nil && !isSingleSeries(expr) {
+			return unboundedSeries
+		}
+		count++
+	}
+	return count
+}
+
+// bucketAggregations returns the aggregations which are applied directly to classic histogram bucket
+// series, i.e. selectors for metrics ending in _bucket. Aggregations applied after histogram_quantile
+// are not included, as the bucket boundaries have already been consumed, and neither are selectors of a
+// single bucket such as x_bucket{le="0.5"}, which count the observations up to that boundary.
+func bucketAggregations(expr parser.Expr) []*parser.AggregateExpr {
+	var aggregations []*parser.AggregateExpr
+	seen := map[*parser.AggregateExpr]struct{}{}
+	parser.Inspect(expr, func(node parser.Node, parents []parser.Node) error {
+		selector, ok := node.(*parser.VectorSelector)
+		if !ok || !strings.HasSuffix(selectorMetricName(selector), "_bucket") {
+			return nil
+		}
+		for _, m := range selector.LabelMatchers {
+			if m.Name == "le" && m.Type == labels.MatchEqual {
+				return nil
+			}
+		}
+		for i := len(parents) - 1; i >= 0; i-- {
+			if call, ok := parents[i].(*parser.Call); ok && call.Func.Name == "histogram_quantile" {
+				return nil
+			}
+			if agg, ok := parents[i].(*parser.AggregateExpr); ok {
+				if _, ok := seen[agg]; !ok {
+					seen[agg] = struct{}{}
+					aggregations = append(aggregations, agg)
+				}
+				return nil
+			}
+		}
+		return nil
+	})
+	return aggregations
+}
+
+// selectorMetricName returns the metric name selected by selector, whether it is given as foo{} or
+// {__name__="foo"}.
+func selectorMetricName(selector *parser.VectorSelector) string {
+	if selector.Name != "" {
+		return selector.Name
+	}
+	for _, m := range selector.LabelMatchers {
+		if m.Name == labels.MetricName && m.Type == labels.MatchEqual {
+			return m.Value
+		}
+	}
+	return ""
+}
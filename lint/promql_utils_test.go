@@ -0,0 +1,86 @@
+package lint
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromQLOutputLabels(t *testing.T) {
+	for _, tc := range []struct {
+		expr   string
+		labels []string
+		known  bool
+	}{
+		{expr: `up`, known: false},
+		{expr: `rate(foo_total[5m])`, known: false},
+		{expr: `sum(rate(foo_total[5m]))`, labels: []string{}, known: true},
+		{expr: `sum by (job, instance) (rate(foo_total[5m]))`, labels: []string{"instance", "job"}, known: true},
+		{expr: `sum without (instance) (rate(foo_total[5m]))`, known: false},
+		{expr: `sum without (instance) (sum by (job, instance) (up))`, labels: []string{"job"}, known: true},
+		{expr: `topk(5, sum by (job) (up))`, labels: []string{"job"}, known: true},
+		{expr: `count_values by (job) ("version", build_info)`, labels: []string{"job", "version"}, known: true},
+		{expr: `histogram_quantile(0.99, sum by (le) (rate(foo_bucket[5m])))`, labels: []string{}, known: true},
+		{expr: `histogram_quantile(0.99, sum by (le, job) (rate(foo_bucket[5m])))`, labels: []string{"job"}, known: true},
+		{expr: `label_replace(sum(up), "foo", "bar", "", "")`, labels: []string{"foo"}, known: true},
+		{expr: `sum(up) / sum(foo)`, labels: []string{}, known: true},
+		{expr: `sum by (job) (up) * 100`, labels: []string{"job"}, known: true},
+		{expr: `sum by (job) (up) or sum by (instance) (up)`, labels: []string{"instance", "job"}, known: true},
+		{expr: `sum by (job) (up) * on (job) group_left (version) sum by (job, version) (build_info)`, labels: []string{"job", "version"}, known: true},
+		{expr: `abs(sum(up))`, labels: []string{}, known: true},
+		{expr: `scalar(sum(up))`, labels: []string{}, known: true},
+		{expr: `vector(1)`, labels: []string{}, known: true},
+		{expr: `1`, labels: []string{}, known: true},
+	} {
+		t.Run(tc.expr, func(t *testing.T) {
+			expr, err := parsePromQL(tc.expr, nil)
+			require.NoError(t, err)
+
+			labels, known := promQLOutputLabels(expr)
+			require.Equal(t, tc.known, known)
+			if !tc.known {
+				return
+			}
+			actual := []string{}
+			for l := range labels {
+				actual = append(actual, l)
+			}
+			sort.Strings(actual)
+			require.Equal(t, tc.labels, actual)
+		})
+	}
+}
+
+func TestEstimateSeriesCount(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		targets  []Target
+		expected int
+	}{
+		{
+			name:     "no targets",
+			expected: 0,
+		},
+		{
+			name:     "aggregated targets",
+			targets:  []Target{{Expr: `sum(up)`}, {Expr: `count(up)`}},
+			expected: 2,
+		},
+		{
+			name:     "unaggregated target",
+			targets:  []Target{{Expr: `sum(up)`}, {Expr: `up`}},
+			expected: unboundedSeries,
+		},
+		{
+			name:     "hidden target",
+			targets:  []Target{{Expr: `sum(up)`}, {Expr: `up`, Hide: true}},
+			expected: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := Panel{Targets: tc.targets}
+			require.Equal(t, tc.expected, estimateSeriesCount(Dashboard{}, p))
+		})
+	}
+}
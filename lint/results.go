@@ -1,9 +1,13 @@
 package lint
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"regexp"
 	"sort"
+	"strings"
 )
 
 var ResultSuccess = Result{
@@ -34,11 +38,24 @@ type TargetRuleResults struct {
 	Results []TargetResult
 }
 
+func targetMessage(d Dashboard, p Panel, t Target, message string) string {
+	return fmt.Sprintf("Dashboard '%s', panel '%s', target idx '%d' %s", d.Title, p.Title, t.Idx, message)
+}
+
 func (r *TargetRuleResults) AddError(d Dashboard, p Panel, t Target, message string) {
 	r.Results = append(r.Results, TargetResult{
 		Result: Result{
 			Severity: Error,
-			Message:  fmt.Sprintf("Dashboard '%s', panel '%s', target idx '%d' %s", d.Title, p.Title, t.Idx, message),
+			Message:  targetMessage(d, p, t, message),
+		},
+	})
+}
+
+func (r *TargetRuleResults) AddWarning(d Dashboard, p Panel, t Target, message string) {
+	r.Results = append(r.Results, TargetResult{
+		Result: Result{
+			Severity: Warning,
+			Message:  targetMessage(d, p, t, message),
 		},
 	})
 }
@@ -52,16 +69,27 @@ type PanelRuleResults struct {
 	Results []PanelResult
 }
 
-func (r *PanelRuleResults) AddError(d Dashboard, p Panel, message string) {
-	msg := fmt.Sprintf("Dashboard '%s', panel '%s' %s", d.Title, p.Title, message)
+func panelMessage(d Dashboard, p Panel, message string) string {
 	if p.Title == "" {
-		msg = fmt.Sprintf("Dashboard '%s', panel with id '%d' %s", d.Title, p.Id, message)
+		return fmt.Sprintf("Dashboard '%s', panel with id '%d' %s", d.Title, p.Id, message)
 	}
+	return fmt.Sprintf("Dashboard '%s', panel '%s' %s", d.Title, p.Title, message)
+}
 
+func (r *PanelRuleResults) AddError(d Dashboard, p Panel, message string) {
 	r.Results = append(r.Results, PanelResult{
 		Result: Result{
 			Severity: Error,
-			Message:  msg,
+			Message:  panelMessage(d, p, message),
+		},
+	})
+}
+
+func (r *PanelRuleResults) AddWarning(d Dashboard, p Panel, message string) {
+	r.Results = append(r.Results, PanelResult{
+		Result: Result{
+			Severity: Warning,
+			Message:  panelMessage(d, p, message),
 		},
 	})
 }
@@ -116,6 +144,34 @@ type ResultContext struct {
 	Target    *Target
 }
 
+// indexRegexp matches the positional indexes in messages, such as the target index of target messages or
+// the index of an annotation, which change when the dashboard is reordered.
+var indexRegexp = regexp.MustCompile(`(, target idx| at index| with index| idx) '?\d+'?`)
+
+// Fingerprint returns a stable identifier for r, one of the results of this ResultContext, so the same
+// finding can be tracked across lint runs. It hashes the rule name, the dashboard UID, the panel id, the
+// target refId and the message without positional indexes, none of which change when panels, targets or
+// annotations are reordered.
+// It is a method of ResultContext rather than of Result, as a Result only holds the severity and message,
+// and is compared by value in rule tests, so it can't carry the rule and dashboard it was reported for.
+func (c ResultContext) Fingerprint(r Result) string {
+	var uid, panelID, refID string
+	if c.Dashboard != nil {
+		uid = c.Dashboard.UID
+	}
+	if c.Panel != nil {
+		panelID = fmt.Sprint(c.Panel.Id)
+	}
+	if c.Target != nil {
+		refID = c.Target.RefId
+	}
+	message := indexRegexp.ReplaceAllString(r.Message, "")
+	message = strings.Join(strings.Fields(message), " ")
+
+	sum := sha256.Sum256([]byte(strings.Join([]string{c.Rule.Name(), uid, panelID, refID, message}, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
 func (r Result) TtyPrint() {
 	var Reset = "\033[0m"
 	var Red = "\033[31m"
@@ -144,6 +200,7 @@ func (r Result) TtyPrint() {
 type ResultSet struct {
 	results []ResultContext
 	config  *ConfigurationFile
+	skipped int
 }
 
 // Configure adds, and applies the provided configuration to all results currently in the ResultSet
@@ -162,6 +219,53 @@ func (rs *ResultSet) AddResult(r ResultContext) {
 	rs.results = append(rs.results, r)
 }
 
+// MergeResults combines the results of several ResultSets, e.g. one per linted dashboard, into a single
+// ResultSet. Each result keeps the Dashboard, Panel and Target it was reported against. The configuration
+// of the first configured set is carried over, and nil sets are skipped. Sets are passed by pointer, as
+// returned by RuleSet.Lint, LintDir and LintFiles, as a ResultSet also holds its unexported configuration and
+// skipped count.
+func MergeResults(sets ...*ResultSet) *ResultSet {
+	merged := &ResultSet{}
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+		merged.results = append(merged.results, set.results...)
+		merged.skipped += set.skipped
+		if merged.config == nil {
+			merged.config = set.config
+		}
+	}
+	return merged
+}
+
+// Skipped returns the number of files which were not linted, because they matched a .lintignore file.
+func (rs *ResultSet) Skipped() int {
+	return rs.skipped
+}
+
+// Strict returns a copy of the ResultSet in which every Warning is promoted to an Error, e.g. so that a
+// release gate fails on warnings. Results of other severities, such as Exclude and Quiet, are unchanged.
+func (rs *ResultSet) Strict() ResultSet {
+	strict := ResultSet{
+		results: make([]ResultContext, len(rs.results)),
+		config:  rs.config,
+		skipped: rs.skipped,
+	}
+	for i, rc := range rs.results {
+		rr := make([]FixableResult, len(rc.Result.Results))
+		copy(rr, rc.Result.Results)
+		for j := range rr {
+			if rr[j].Severity == Warning {
+				rr[j].Severity = Error
+			}
+		}
+		rc.Result = RuleResults{rr}
+		strict.results[i] = rc
+	}
+	return strict
+}
+
 func (rs *ResultSet) MaximumSeverity() Severity {
 	retVal := Success
 	for _, res := range rs.results {
@@ -206,6 +310,10 @@ func (rs *ResultSet) ReportByRule() {
 			}
 		}
 	}
+
+	if rs.skipped > 0 {
+		fmt.Fprintf(os.Stdout, "Skipped %d files matching %s\n", rs.skipped, IgnoreFileName)
+	}
 }
 
 func (rs *ResultSet) AutoFix(d *Dashboard) int {
@@ -0,0 +1,60 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	hexColorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	rgbColorRegexp = regexp.MustCompile(`^rgba?\(\s*\d{1,3}%?\s*,\s*\d{1,3}%?\s*,\s*\d{1,3}%?\s*(,\s*(\d*\.)?\d+%?\s*)?\)$`)
+)
+
+// namedColors are the colors of the Grafana palette, which may be prefixed with a shade, e.g. "dark-red",
+// and the basic CSS color keywords.
+var (
+	namedColors = []string{
+		"red", "orange", "yellow", "green", "blue", "purple",
+		"transparent", "text",
+		"black", "white", "gray", "grey", "silver", "maroon", "fuchsia", "lime", "olive", "navy", "teal", "aqua",
+	}
+	namedColorShades = []string{"dark-", "semi-dark-", "light-", "super-light-"}
+)
+
+// isValidColor returns true if c is a hex, rgb(a) or named color understood by Grafana.
+func isValidColor(c string) bool {
+	if hexColorRegexp.MatchString(c) || rgbColorRegexp.MatchString(c) {
+		return true
+	}
+	for _, shade := range namedColorShades {
+		c = strings.TrimPrefix(c, shade)
+	}
+	for _, name := range namedColors {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+func NewAnnotationConfigRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "annotation-config-rule",
+		description: "Checks that each annotation has a name and a valid icon color.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			for i, a := range d.Annotations.List {
+				if a.Name == "" {
+					r.AddWarning(d, fmt.Sprintf("annotation at index %d has no name", i))
+				}
+				if a.IconColor != "" && !isValidColor(a.IconColor) {
+					r.AddWarning(d, fmt.Sprintf("annotation '%s' has invalid iconColor '%s'", a.Name, a.IconColor))
+				}
+			}
+
+			return r
+		},
+	}
+}
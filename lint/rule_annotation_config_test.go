@@ -0,0 +1,64 @@
+package lint
+
+import "testing"
+
+func TestAnnotationConfigRule(t *testing.T) {
+	linter := NewAnnotationConfigRule()
+
+	for _, tc := range []struct {
+		name       string
+		result     Result
+		annotation Annotation
+	}{
+		{
+			name:       "rgba",
+			result:     ResultSuccess,
+			annotation: Annotation{Name: "Deploys", Enable: true, IconColor: "rgba(0, 211, 255, 1)"},
+		},
+		{
+			name:       "hex",
+			result:     ResultSuccess,
+			annotation: Annotation{Name: "Deploys", IconColor: "#FF9830"},
+		},
+		{
+			name:       "named",
+			result:     ResultSuccess,
+			annotation: Annotation{Name: "Deploys", IconColor: "semi-dark-purple"},
+		},
+		{
+			name:       "unset color",
+			result:     ResultSuccess,
+			annotation: Annotation{Name: "Deploys"},
+		},
+		{
+			name: "missing name",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' annotation at index 0 has no name",
+			},
+			annotation: Annotation{IconColor: "red"},
+		},
+		{
+			name: "invalid color",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' annotation 'Deploys' has invalid iconColor '#GGG'",
+			},
+			annotation: Annotation{Name: "Deploys", IconColor: "#GGG"},
+		},
+		{
+			name: "unknown named color",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' annotation 'Deploys' has invalid iconColor 'dark-bluish'",
+			},
+			annotation: Annotation{Name: "Deploys", IconColor: "dark-bluish"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{Title: "test"}
+			d.Annotations.List = []Annotation{tc.annotation}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
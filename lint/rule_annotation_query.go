@@ -0,0 +1,48 @@
+package lint
+
+import "fmt"
+
+func NewAnnotationQueryRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "annotation-query-rule",
+		description: "Checks that enabled Prometheus annotations use a valid PromQL query.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			for _, a := range d.Annotations.List {
+				expr := a.GetExpr()
+				if !a.Enable || expr == "" || annotationDatasourceType(d, a) != Prometheus {
+					continue
+				}
+				if macros := datasourceMacroRegexp.FindAllString(expr, -1); len(macros) > 0 {
+					for _, macro := range macros {
+						r.AddError(d, fmt.Sprintf("annotation '%s' uses '%s', which is not supported by Prometheus", a.Name, macro))
+					}
+					continue
+				}
+				if _, err := parsePromQL(expr, d.Templating.List); err != nil {
+					r.AddError(d, fmt.Sprintf("annotation '%s' has invalid PromQL query '%s': %v", a.Name, expr, err))
+				}
+			}
+			return r
+		},
+	}
+}
+
+// annotationDatasourceType returns the type of the datasource queried by an annotation. When the datasource
+// has no type, but references a variable, the query of the dashboard's templated datasource is used.
+func annotationDatasourceType(d Dashboard, a Annotation) string {
+	ds, err := a.GetDataSource()
+	if err != nil {
+		return ""
+	}
+	if ds.Type != "" {
+		return ds.Type
+	}
+	if hasVariableReference(ds.UID) {
+		if template := getTemplateDatasource(d); template != nil {
+			return template.Query
+		}
+	}
+	return ""
+}
@@ -0,0 +1,74 @@
+package lint
+
+import "testing"
+
+func TestAnnotationQueryRule(t *testing.T) {
+	linter := NewAnnotationQueryRule()
+	prometheus := map[string]interface{}{"type": "prometheus", "uid": "prom"}
+
+	for _, tc := range []struct {
+		name        string
+		result      Result
+		annotations []Annotation
+	}{
+		{
+			name:   "valid",
+			result: ResultSuccess,
+			annotations: []Annotation{
+				{Name: "Deploys", Enable: true, Datasource: prometheus, Expr: `changes(build_info{job="$job"}[$__rate_interval]) > 0`},
+			},
+		},
+		{
+			name:   "disabled",
+			result: ResultSuccess,
+			annotations: []Annotation{
+				{Name: "Deploys", Datasource: prometheus, Expr: `changes(build_info[5m]) > 0 AND $__timeFilter(time)`},
+			},
+		},
+		{
+			name:   "not prometheus",
+			result: ResultSuccess,
+			annotations: []Annotation{
+				{Name: "Deploys", Enable: true, Datasource: map[string]interface{}{"type": "mysql", "uid": "sql"}, Expr: `SELECT time FROM deploys WHERE $__timeFilter(time)`},
+			},
+		},
+		{
+			name: "sql macro",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test' annotation 'Deploys' uses '$__timeFilter', which is not supported by Prometheus",
+			},
+			annotations: []Annotation{
+				{Name: "Deploys", Enable: true, Datasource: prometheus, Expr: `changes(build_info[5m]) > 0 AND $__timeFilter(time)`},
+			},
+		},
+		{
+			name: "templated datasource and target",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test' annotation 'Restarts' has invalid PromQL query 'resets(process_start_time_seconds[5m]': 1:38: parse error: unclosed left parenthesis",
+			},
+			annotations: []Annotation{
+				{Name: "Restarts", Enable: true, Datasource: map[string]interface{}{"uid": "$datasource"}, Target: &Target{Expr: `resets(process_start_time_seconds[5m]`}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{
+						{Name: "datasource", Type: "datasource", Query: "prometheus"},
+						{Name: "job", Type: "query"},
+					},
+				},
+				Annotations: struct {
+					List []Annotation `json:"list"`
+				}{List: tc.annotations},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+func NewCrossPanelUnitRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-cross-panel-unit-rule",
+		description: "Checks that panels showing the same query use the same unit.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			type usage struct {
+				panel, unit string
+			}
+			var exprs []string
+			usages := map[string][]usage{}
+			// Queries are grouped by their normalized form, but reported as first written.
+			display := map[string]string{}
+			for _, p := range d.GetPanels() {
+				if len(p.Panels) > 0 {
+					// Only leaf panels show queries.
+					continue
+				}
+				unit := getConfiguredUnit(p)
+				if unit == "" {
+					continue
+				}
+				for _, t := range p.Targets {
+					expr := normalizeExpr(t.Expr, d.Templating.List)
+					if expr == "" {
+						continue
+					}
+					if _, ok := usages[expr]; !ok {
+						exprs = append(exprs, expr)
+						display[expr] = collapseWhitespace(t.Expr)
+					}
+					usages[expr] = append(usages[expr], usage{panel: p.Title, unit: unit})
+				}
+			}
+
+			for _, expr := range exprs {
+				conflict := false
+				for _, u := range usages[expr][1:] {
+					if u.unit != usages[expr][0].unit {
+						conflict = true
+						break
+					}
+				}
+				if !conflict {
+					continue
+				}
+				var panels []string
+				for _, u := range usages[expr] {
+					panels = append(panels, fmt.Sprintf("panel '%s' uses '%s'", u.panel, u.unit))
+				}
+				r.AddWarning(d, fmt.Sprintf("shows query '%s' with different units: %s", display[expr], strings.Join(panels, ", ")))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package lint
+
+import "testing"
+
+func TestCrossPanelUnitRule(t *testing.T) {
+	linter := NewCrossPanelUnitRule()
+
+	panel := func(title, unit string, exprs ...string) Panel {
+		p := Panel{
+			Type:        "timeseries",
+			Title:       title,
+			FieldConfig: &FieldConfig{Defaults: Defaults{Unit: unit}},
+		}
+		for _, expr := range exprs {
+			p.Targets = append(p.Targets, Target{Expr: expr})
+		}
+		return p
+	}
+
+	for _, tc := range []struct {
+		name   string
+		result Result
+		panels []Panel
+	}{
+		{
+			name:   "same unit",
+			result: ResultSuccess,
+			panels: []Panel{
+				panel("a", "bytes", `sum(memory_bytes)`),
+				panel("b", "bytes", `sum(memory_bytes)`),
+			},
+		},
+		{
+			name:   "different queries",
+			result: ResultSuccess,
+			panels: []Panel{
+				panel("a", "bytes", `sum(memory_bytes)`),
+				panel("b", "percentunit", `sum(memory_bytes) / sum(memory_limit_bytes)`),
+			},
+		},
+		{
+			name:   "no unit",
+			result: ResultSuccess,
+			panels: []Panel{
+				panel("a", "bytes", `sum(memory_bytes)`),
+				panel("b", "", `sum(memory_bytes)`),
+			},
+		},
+		{
+			name: "different units",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' shows query 'sum(memory_bytes)' with different units: panel 'a' uses 'bytes', panel 'b' uses 'decbytes'",
+			},
+			panels: []Panel{
+				panel("a", "bytes", `sum(memory_bytes)`, `sum(cpu_seconds_total)`),
+				panel("b", "decbytes", "sum(\n  memory_bytes\n)"),
+			},
+		},
+		{
+			name: "nested panels",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' shows query 'sum(memory_bytes)' with different units: panel 'a' uses 'bytes', panel 'b' uses 'decbytes'",
+			},
+			panels: []Panel{
+				panel("a", "bytes", `sum(memory_bytes)`),
+				{
+					Type:   "row",
+					Title:  "row",
+					Panels: []Panel{panel("b", "decbytes", `sum(memory_bytes)`)},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, linter, Dashboard{Title: "test", Panels: tc.panels}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+func NewDashboardDatasourceConsistencyRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-datasource-consistency-rule",
+		description: "Checks that dashboards without a datasource variable use a single datasource.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			if len(d.GetTemplateByType("datasource")) > 0 {
+				// Panels not using the variable are reported by panel-datasource-rule.
+				return r
+			}
+
+			var uids []string
+			seen := map[string]struct{}{}
+			for _, p := range d.GetPanels() {
+				src, err := p.GetDataSource()
+				if err != nil {
+					// Invalid datasources are reported by panel-datasource-rule.
+					continue
+				}
+				// Skip variables, and the special "-- Mixed --", "-- Dashboard --" and "-- Grafana --" datasources.
+				if src.UID == "" || strings.Contains(src.UID, "$") || strings.HasPrefix(src.UID, "--") {
+					continue
+				}
+				if _, ok := seen[src.UID]; ok {
+					continue
+				}
+				seen[src.UID] = struct{}{}
+				uids = append(uids, fmt.Sprintf("'%s'", src.UID))
+			}
+
+			if len(uids) > 1 {
+				r.AddWarning(d, fmt.Sprintf("uses %d different datasources (%s), consider adding a datasource template variable", len(uids), strings.Join(uids, ", ")))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package lint
+
+import "testing"
+
+func TestDashboardDatasourceConsistencyRule(t *testing.T) {
+	linter := NewDashboardDatasourceConsistencyRule()
+
+	prom := func(uid string) map[string]interface{} {
+		return map[string]interface{}{"uid": uid, "type": "prometheus"}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		templates []Template
+		panels    []Panel
+	}{
+		{
+			name:   "single datasource",
+			result: ResultSuccess,
+			panels: []Panel{
+				{Title: "a", Datasource: prom("prom-1")},
+				{Title: "b", Datasource: prom("prom-1")},
+			},
+		},
+		{
+			name:   "special datasources",
+			result: ResultSuccess,
+			panels: []Panel{
+				{Title: "a", Datasource: prom("prom-1")},
+				{Title: "b", Datasource: "-- Mixed --"},
+				{Title: "c", Datasource: "-- Dashboard --"},
+			},
+		},
+		{
+			name:      "datasource variable",
+			result:    ResultSuccess,
+			templates: []Template{{Name: "datasource", Type: "datasource", Query: "prometheus"}},
+			panels: []Panel{
+				{Title: "a", Datasource: prom("prom-1")},
+				{Title: "b", Datasource: prom("prom-2")},
+			},
+		},
+		{
+			name: "multiple datasources",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' uses 2 different datasources ('prom-1', 'prom-2'), consider adding a datasource template variable",
+			},
+			panels: []Panel{
+				{Title: "a", Datasource: prom("prom-1")},
+				{Title: "b", Datasource: prom("prom-2")},
+				{Title: "c", Datasource: prom("prom-1")},
+				{Title: "d", Datasource: "$datasource"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: tc.templates,
+				},
+				Panels: tc.panels,
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
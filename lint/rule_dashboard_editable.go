@@ -0,0 +1,28 @@
+package lint
+
+import "fmt"
+
+// NewDashboardEditableRule is not part of the default rule set, as Grafana treats dashboards which don't set
+// editable as editable, which is often intended, and uneditable-dashboard already reports editable ones.
+func NewDashboardEditableRule() *DashboardRuleFunc {
+	return NewDashboardEditableRuleWithExpected(false)
+}
+
+// NewDashboardEditableRuleWithExpected is like NewDashboardEditableRule, but allows the expected value of
+// the editable flag to be configured.
+func NewDashboardEditableRuleWithExpected(expected bool) *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-editable-rule",
+		description: "Checks that the dashboard sets the editable flag explicitly to the expected value.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			if !d.editableSet {
+				r.AddWarning(d, fmt.Sprintf("does not set 'editable', it should be set to 'editable: %t'", expected))
+			} else if expected && !d.Editable {
+				// Editable dashboards are reported by uneditable-dashboard.
+				r.AddWarning(d, "has 'editable: false', it should be set to 'editable: true'")
+			}
+			return r
+		},
+	}
+}
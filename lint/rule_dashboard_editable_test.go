@@ -0,0 +1,89 @@
+package lint
+
+import "testing"
+
+func TestDashboardEditableRule(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		linter    Rule
+		result    Result
+		dashboard Dashboard
+	}{
+		{
+			name:      "uneditable",
+			linter:    NewDashboardEditableRule(),
+			result:    ResultSuccess,
+			dashboard: Dashboard{Title: "test", Editable: false, editableSet: true},
+		},
+		{
+			name:   "unset",
+			linter: NewDashboardEditableRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' does not set 'editable', it should be set to 'editable: false'",
+			},
+			dashboard: Dashboard{Title: "test"},
+		},
+		{
+			// Reported by uneditable-dashboard.
+			name:      "editable",
+			linter:    NewDashboardEditableRule(),
+			result:    ResultSuccess,
+			dashboard: Dashboard{Title: "test", Editable: true, editableSet: true},
+		},
+		{
+			name:      "expected editable",
+			linter:    NewDashboardEditableRuleWithExpected(true),
+			result:    ResultSuccess,
+			dashboard: Dashboard{Title: "test", Editable: true, editableSet: true},
+		},
+		{
+			name:   "expected editable but uneditable",
+			linter: NewDashboardEditableRuleWithExpected(true),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has 'editable: false', it should be set to 'editable: true'",
+			},
+			dashboard: Dashboard{Title: "test", Editable: false, editableSet: true},
+		},
+		{
+			name:   "expected editable but unset",
+			linter: NewDashboardEditableRuleWithExpected(true),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' does not set 'editable', it should be set to 'editable: true'",
+			},
+			dashboard: Dashboard{Title: "test"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, tc.linter, tc.dashboard, tc.result)
+		})
+	}
+}
+
+func TestDashboardEditableSet(t *testing.T) {
+	for _, tc := range []struct {
+		json     string
+		editable bool
+		set      bool
+	}{
+		{json: `{"title": "test"}`},
+		{json: `{"title": "test", "editable": false}`, set: true},
+		{json: `{"title": "test", "editable": true}`, editable: true, set: true},
+		{json: `{"apiVersion": "v1", "spec": {"title": "test", "editable": false}}`, set: true},
+	} {
+		t.Run(tc.json, func(t *testing.T) {
+			d, err := NewDashboard([]byte(tc.json))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.Editable != tc.editable || d.editableSet != tc.set {
+				t.Errorf("got editable %t, set %t, want %t, %t", d.Editable, d.editableSet, tc.editable, tc.set)
+			}
+			if d.Title != "test" {
+				t.Errorf("got title %q", d.Title)
+			}
+		})
+	}
+}
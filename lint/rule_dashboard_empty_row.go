@@ -0,0 +1,41 @@
+package lint
+
+import "fmt"
+
+func NewEmptyRowRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-empty-row-rule",
+		description: "Checks that the dashboard does not contain rows without panels.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			// Collapsed rows nest their panels, whereas expanded rows are followed by their panels.
+			for i, p := range d.Panels {
+				if p.Type != panelTypeRow || len(p.Panels) > 0 {
+					continue
+				}
+				if i+1 < len(d.Panels) && d.Panels[i+1].Type != panelTypeRow {
+					continue
+				}
+				if p.Title == "" {
+					r.AddWarning(d, fmt.Sprintf("row with id '%d' has no panels", p.Id))
+				} else {
+					r.AddWarning(d, fmt.Sprintf("row '%s' has no panels", p.Title))
+				}
+			}
+
+			// Rows are deprecated, but still supported by Grafana.
+			for i, row := range d.Rows {
+				if len(row.Panels) > 0 {
+					continue
+				}
+				if row.Title == "" {
+					r.AddWarning(d, fmt.Sprintf("row with index '%d' has no panels", i))
+				} else {
+					r.AddWarning(d, fmt.Sprintf("row '%s' has no panels", row.Title))
+				}
+			}
+			return r
+		},
+	}
+}
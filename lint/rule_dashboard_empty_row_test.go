@@ -0,0 +1,84 @@
+package lint
+
+import "testing"
+
+func TestEmptyRowRule(t *testing.T) {
+	linter := NewEmptyRowRule()
+	stat := Panel{Id: 10, Type: "stat", Title: "stat"}
+
+	for _, tc := range []struct {
+		name      string
+		results   []Result
+		dashboard Dashboard
+	}{
+		{
+			name:    "expanded row",
+			results: []Result{ResultSuccess},
+			dashboard: Dashboard{
+				Title: "test",
+				Panels: []Panel{
+					{Id: 1, Type: "row", Title: "Overview"},
+					stat,
+				},
+			},
+		},
+		{
+			name:    "collapsed row",
+			results: []Result{ResultSuccess},
+			dashboard: Dashboard{
+				Title: "test",
+				Panels: []Panel{
+					{Id: 1, Type: "row", Title: "Overview", Panels: []Panel{stat}},
+					{Id: 2, Type: "row", Title: "Details", Panels: []Panel{stat}},
+				},
+			},
+		},
+		{
+			name: "empty rows",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' row 'Overview' has no panels",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' row with id '3' has no panels",
+				},
+			},
+			dashboard: Dashboard{
+				Title: "test",
+				Panels: []Panel{
+					{Id: 1, Type: "row", Title: "Overview"},
+					{Id: 2, Type: "row", Title: "Details"},
+					stat,
+					{Id: 3, Type: "row"},
+				},
+			},
+		},
+		{
+			name: "legacy rows",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' row 'Overview' has no panels",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' row with index '2' has no panels",
+				},
+			},
+			dashboard: Dashboard{
+				Title: "test",
+				Rows: []Row{
+					{Title: "Overview"},
+					{Title: "Details", Panels: []Panel{stat}},
+					{},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testMultiResultRule(t, linter, tc.dashboard, tc.results)
+		})
+	}
+}
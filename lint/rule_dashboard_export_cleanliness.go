@@ -0,0 +1,29 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+func NewExportCleanlinessRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-export-cleanliness-rule",
+		description: "Checks that dashboards do not contain the iteration timestamp or an absolute time range.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			if d.Iteration != nil {
+				r.AddWarning(d, "contains an 'iteration' field, which changes every time the dashboard is saved")
+			}
+			if d.Time != nil && (isAbsoluteTime(d.Time.From) || isAbsoluteTime(d.Time.To)) {
+				r.AddWarning(d, fmt.Sprintf("has an absolute 'time' range from '%s' to '%s', use a relative range such as 'now-6h'", d.Time.From, d.Time.To))
+			}
+			return r
+		},
+	}
+}
+
+// isAbsoluteTime reports whether a time range value is absolute, i.e. a millisecond timestamp or a date,
+// rather than relative to now.
+func isAbsoluteTime(value string) bool {
+	return value != "" && !strings.HasPrefix(value, "now")
+}
@@ -0,0 +1,66 @@
+package lint
+
+import "testing"
+
+func TestExportCleanlinessRule(t *testing.T) {
+	linter := NewExportCleanlinessRule()
+	iteration := int64(1700000000000)
+
+	for _, tc := range []struct {
+		name      string
+		results   []Result
+		iteration *int64
+		time      *TimeRange
+	}{
+		{
+			name:    "no fields",
+			results: []Result{ResultSuccess},
+		},
+		{
+			name:    "relative time",
+			results: []Result{ResultSuccess},
+			time:    &TimeRange{From: "now-6h", To: "now"},
+		},
+		{
+			name: "iteration",
+			results: []Result{{
+				Severity: Warning,
+				Message:  "Dashboard 'test' contains an 'iteration' field, which changes every time the dashboard is saved",
+			}},
+			iteration: &iteration,
+			time:      &TimeRange{From: "now-6h", To: "now"},
+		},
+		{
+			name: "absolute time",
+			results: []Result{{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has an absolute 'time' range from '1700000000000' to 'now', use a relative range such as 'now-6h'",
+			}},
+			time: &TimeRange{From: "1700000000000", To: "now"},
+		},
+		{
+			name: "both",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' contains an 'iteration' field, which changes every time the dashboard is saved",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' has an absolute 'time' range from '2023-11-14T22:13:20.000Z' to '2023-11-15T22:13:20.000Z', use a relative range such as 'now-6h'",
+				},
+			},
+			iteration: &iteration,
+			time:      &TimeRange{From: "2023-11-14T22:13:20.000Z", To: "2023-11-15T22:13:20.000Z"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title:     "test",
+				Iteration: tc.iteration,
+				Time:      tc.time,
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
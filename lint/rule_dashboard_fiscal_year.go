@@ -0,0 +1,28 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// fiscalTimeRegexp matches relative times using the fiscal quarter or fiscal year units, e.g. now/fQ or
+// now-1fy.
+var fiscalTimeRegexp = regexp.MustCompile(`^now.*f[Qy]`)
+
+func NewFiscalYearRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-fiscal-year-rule",
+		description: "Checks that fiscalYearStartMonth is only set on dashboards using a fiscal time range.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			if d.FiscalYearStartMonth == 0 {
+				return r
+			}
+			if d.Time != nil && (fiscalTimeRegexp.MatchString(d.Time.From) || fiscalTimeRegexp.MatchString(d.Time.To)) {
+				return r
+			}
+			r.AddWarning(d, fmt.Sprintf("sets fiscalYearStartMonth to %d, but does not use a fiscal time range", d.FiscalYearStartMonth))
+			return r
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package lint
+
+import "testing"
+
+func TestFiscalYearRule(t *testing.T) {
+	linter := NewFiscalYearRule()
+
+	for _, tc := range []struct {
+		name       string
+		result     Result
+		startMonth int
+		time       *TimeRange
+	}{
+		{
+			name:   "unset",
+			result: ResultSuccess,
+			time:   &TimeRange{From: "now-6h", To: "now"},
+		},
+		{
+			name:       "fiscal quarter",
+			result:     ResultSuccess,
+			startMonth: 3,
+			time:       &TimeRange{From: "now/fQ", To: "now/fQ"},
+		},
+		{
+			name:       "previous fiscal year",
+			result:     ResultSuccess,
+			startMonth: 3,
+			time:       &TimeRange{From: "now-1fy/fy", To: "now-1fy/fy"},
+		},
+		{
+			name: "no fiscal range",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' sets fiscalYearStartMonth to 3, but does not use a fiscal time range",
+			},
+			startMonth: 3,
+			time:       &TimeRange{From: "now-6h", To: "now"},
+		},
+		{
+			name: "no time",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' sets fiscalYearStartMonth to 6, but does not use a fiscal time range",
+			},
+			startMonth: 6,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title:                "test",
+				FiscalYearStartMonth: tc.startMonth,
+				Time:                 tc.time,
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+var graphTooltipNames = map[int]string{
+	0: "default",
+	1: "shared crosshair",
+	2: "shared tooltip",
+}
+
+func NewGraphTooltipRule() *DashboardRuleFunc {
+	return NewGraphTooltipRuleWithExpected(1, 2)
+}
+
+// NewGraphTooltipRuleWithExpected is like NewGraphTooltipRule, but allows the accepted graphTooltip values
+// to be configured, for example 0 for teams which prefer individual tooltips.
+func NewGraphTooltipRuleWithExpected(expected ...int) *DashboardRuleFunc {
+	names := make([]string, len(expected))
+	for i, v := range expected {
+		names[i] = fmt.Sprintf("%d (%s)", v, graphTooltipNames[v])
+	}
+
+	return &DashboardRuleFunc{
+		name:        "dashboard-graph-tooltip-rule",
+		description: "Checks that the dashboard uses a shared crosshair or tooltip.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			for _, v := range expected {
+				if d.GraphTooltip == v {
+					return r
+				}
+			}
+			r.AddWarning(d, fmt.Sprintf("has 'graphTooltip: %d' (%s), it should be one of %s", d.GraphTooltip, graphTooltipNames[d.GraphTooltip], strings.Join(names, ", ")))
+			return r
+		},
+	}
+}
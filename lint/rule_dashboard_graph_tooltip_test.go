@@ -0,0 +1,51 @@
+package lint
+
+import "testing"
+
+func TestGraphTooltipRule(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		linter       Rule
+		result       Result
+		graphTooltip int
+	}{
+		{
+			name:         "shared crosshair",
+			linter:       NewGraphTooltipRule(),
+			result:       ResultSuccess,
+			graphTooltip: 1,
+		},
+		{
+			name:         "shared tooltip",
+			linter:       NewGraphTooltipRule(),
+			result:       ResultSuccess,
+			graphTooltip: 2,
+		},
+		{
+			name:   "default",
+			linter: NewGraphTooltipRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has 'graphTooltip: 0' (default), it should be one of 1 (shared crosshair), 2 (shared tooltip)",
+			},
+		},
+		{
+			name:   "individual tooltips expected",
+			linter: NewGraphTooltipRuleWithExpected(0),
+			result: ResultSuccess,
+		},
+		{
+			name:   "individual tooltips expected but shared",
+			linter: NewGraphTooltipRuleWithExpected(0),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has 'graphTooltip: 2' (shared tooltip), it should be one of 0 (default)",
+			},
+			graphTooltip: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, tc.linter, Dashboard{Title: "test", GraphTooltip: tc.graphTooltip}, tc.result)
+		})
+	}
+}
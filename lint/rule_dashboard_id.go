@@ -0,0 +1,17 @@
+package lint
+
+import "fmt"
+
+func NewDashboardIDRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-id-rule",
+		description: "Checks that the dashboard id is null or absent.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			if d.Id != nil {
+				r.AddError(d, fmt.Sprintf("has id '%d', it should be removed or set to null", *d.Id))
+			}
+			return r
+		},
+	}
+}
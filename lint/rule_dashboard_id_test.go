@@ -0,0 +1,43 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDashboardIDRule(t *testing.T) {
+	linter := NewDashboardIDRule()
+
+	for _, tc := range []struct {
+		name   string
+		result Result
+		json   string
+	}{
+		{
+			name:   "absent",
+			result: ResultSuccess,
+			json:   `{"title": "test"}`,
+		},
+		{
+			name:   "null",
+			result: ResultSuccess,
+			json:   `{"title": "test", "id": null}`,
+		},
+		{
+			name: "set",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test' has id '42', it should be removed or set to null",
+			},
+			json: `{"title": "test", "id": 42}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var d Dashboard
+			if err := json.Unmarshal([]byte(tc.json), &d); err != nil {
+				t.Fatal(err)
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
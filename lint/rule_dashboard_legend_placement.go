@@ -0,0 +1,60 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+func NewLegendPlacementConsistencyRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-legend-placement-rule",
+		description: "Checks that timeseries panels place their legends consistently.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			var placements []string
+			panels := map[string][]string{}
+			for _, p := range d.GetPanels() {
+				if p.Type != panelTypeTimeSeries || len(p.Options) == 0 {
+					continue
+				}
+				var opts TimeseriesOptions
+				if err := json.Unmarshal(p.Options, &opts); err != nil {
+					// Invalid options are reported by panel rules.
+					continue
+				}
+				legend := opts.Legend
+				if legend == nil || (legend.ShowLegend != nil && !*legend.ShowLegend) || legend.DisplayMode == "hidden" {
+					continue
+				}
+				placement := legend.Placement
+				if placement == "" {
+					placement = "bottom"
+				}
+				if _, ok := panels[placement]; !ok {
+					placements = append(placements, placement)
+				}
+				panels[placement] = append(panels[placement], fmt.Sprintf("'%s'", p.Title))
+			}
+			if len(placements) < 2 {
+				return r
+			}
+
+			// Ties are broken by the placement of the first panel.
+			majority := placements[0]
+			for _, placement := range placements[1:] {
+				if len(panels[placement]) > len(panels[majority]) {
+					majority = placement
+				}
+			}
+			for _, placement := range placements {
+				if placement == majority {
+					continue
+				}
+				r.AddWarning(d, fmt.Sprintf("places the legend of panels %s on the '%s', whereas most timeseries panels place it on the '%s'", strings.Join(panels[placement], ", "), placement, majority))
+			}
+			return r
+		},
+	}
+}
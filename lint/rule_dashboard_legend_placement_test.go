@@ -0,0 +1,77 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLegendPlacementConsistencyRule(t *testing.T) {
+	linter := NewLegendPlacementConsistencyRule()
+
+	panel := func(title, options string) Panel {
+		return Panel{Type: "timeseries", Title: title, Options: json.RawMessage(options)}
+	}
+	bottom := `{"legend": {"displayMode": "list", "placement": "bottom"}}`
+	right := `{"legend": {"displayMode": "table", "placement": "right"}}`
+
+	for _, tc := range []struct {
+		name    string
+		results []Result
+		panels  []Panel
+	}{
+		{
+			name:    "consistent",
+			results: []Result{ResultSuccess},
+			panels: []Panel{
+				panel("a", bottom),
+				panel("b", `{"legend": {"displayMode": "list"}}`),
+			},
+		},
+		{
+			name:    "hidden legend",
+			results: []Result{ResultSuccess},
+			panels: []Panel{
+				panel("a", bottom),
+				panel("b", `{"legend": {"showLegend": false, "placement": "right"}}`),
+				panel("c", `{"legend": {"displayMode": "hidden", "placement": "right"}}`),
+			},
+		},
+		{
+			name:    "not a timeseries",
+			results: []Result{ResultSuccess},
+			panels: []Panel{
+				panel("a", bottom),
+				{Type: "piechart", Title: "b", Options: json.RawMessage(right)},
+			},
+		},
+		{
+			name: "minority placement",
+			results: []Result{{
+				Severity: Warning,
+				Message:  "Dashboard 'test' places the legend of panels 'b' on the 'right', whereas most timeseries panels place it on the 'bottom'",
+			}},
+			panels: []Panel{
+				panel("a", bottom),
+				panel("b", right),
+				panel("c", bottom),
+			},
+		},
+		{
+			name: "tie",
+			results: []Result{{
+				Severity: Warning,
+				Message:  "Dashboard 'test' places the legend of panels 'b', 'c' on the 'bottom', whereas most timeseries panels place it on the 'right'",
+			}},
+			panels: []Panel{
+				panel("a", right),
+				panel("b", bottom),
+				panel("c", bottom),
+				panel("d", right),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testMultiResultRule(t, linter, Dashboard{Title: "test", Panels: tc.panels}, tc.results)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+func NewDashboardLinkVarsRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-link-vars-rule",
+		description: "Checks that dashboard links to other dashboards carry the current variable values.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			for _, link := range d.Links {
+				if link.Type != "dashboards" || link.IncludeVars {
+					continue
+				}
+				name := fmt.Sprintf("'%s'", link.Title)
+				if link.Title == "" {
+					name = fmt.Sprintf("with tags '%s'", strings.Join(link.Tags, ", "))
+				}
+				r.AddWarning(d, fmt.Sprintf("has a dashboards link %s which does not include the current variable values, set includeVars to true", name))
+			}
+			return r
+		},
+	}
+}
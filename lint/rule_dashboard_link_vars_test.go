@@ -0,0 +1,66 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDashboardLinkVarsRule(t *testing.T) {
+	linter := NewDashboardLinkVarsRule()
+
+	for _, tc := range []struct {
+		name    string
+		results []Result
+		links   []DashboardLink
+	}{
+		{
+			name:    "no links",
+			results: []Result{ResultSuccess},
+		},
+		{
+			name:    "includes variables",
+			results: []Result{ResultSuccess},
+			links: []DashboardLink{
+				{Title: "Services", Type: "dashboards", Tags: []string{"service"}, AsDropdown: true, IncludeVars: true},
+			},
+		},
+		{
+			name:    "not a dashboards link",
+			results: []Result{ResultSuccess},
+			links: []DashboardLink{
+				{Title: "Runbook", Type: "link", Url: "https://example.com/runbook"},
+			},
+		},
+		{
+			name: "missing variables",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' has a dashboards link 'Services' which does not include the current variable values, set includeVars to true",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' has a dashboards link with tags 'mixin, kubernetes' which does not include the current variable values, set includeVars to true",
+				},
+			},
+			links: []DashboardLink{
+				{Title: "Services", Type: "dashboards", Tags: []string{"service"}, AsDropdown: true},
+				{Title: "Databases", Type: "dashboards", Tags: []string{"database"}, IncludeVars: true},
+				{Type: "dashboards", Tags: []string{"mixin", "kubernetes"}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testMultiResultRule(t, linter, Dashboard{Title: "test", Links: tc.links}, tc.results)
+		})
+	}
+}
+
+func TestDashboardLinksUnmarshal(t *testing.T) {
+	d, err := NewDashboard([]byte(`{"title": "test", "links": [{"title": "Services", "type": "dashboards", "tags": ["service"], "asDropdown": true, "includeVars": true, "keepTime": true}]}`))
+	require.NoError(t, err)
+	require.Equal(t, []DashboardLink{
+		{Title: "Services", Type: "dashboards", Tags: []string{"service"}, AsDropdown: true, IncludeVars: true, KeepTime: true},
+	}, d.Links)
+}
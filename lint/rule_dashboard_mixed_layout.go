@@ -0,0 +1,27 @@
+package lint
+
+import "fmt"
+
+func NewMixedLayoutRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-mixed-layout-rule",
+		description: "Checks that the dashboard does not mix the deprecated rows layout with row panels.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			if len(d.Rows) == 0 {
+				return r
+			}
+
+			rowPanels := 0
+			for _, p := range d.Panels {
+				if p.Type == panelTypeRow {
+					rowPanels++
+				}
+			}
+			if rowPanels > 0 {
+				r.AddError(d, fmt.Sprintf("mixes the deprecated 'rows' layout, with %d rows, and the 'panels' layout, with %d row panels", len(d.Rows), rowPanels))
+			}
+			return r
+		},
+	}
+}
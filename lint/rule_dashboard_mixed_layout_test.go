@@ -0,0 +1,57 @@
+package lint
+
+import "testing"
+
+func TestMixedLayoutRule(t *testing.T) {
+	linter := NewMixedLayoutRule()
+
+	for _, tc := range []struct {
+		name   string
+		result Result
+		rows   []Row
+		panels []Panel
+	}{
+		{
+			name:   "panels layout",
+			result: ResultSuccess,
+			panels: []Panel{
+				{Type: "row", Title: "Overview"},
+				{Type: "timeseries", Title: "CPU"},
+			},
+		},
+		{
+			name:   "rows layout",
+			result: ResultSuccess,
+			rows: []Row{
+				{Title: "Overview", Panels: []Panel{{Type: "graph", Title: "CPU"}}},
+			},
+		},
+		{
+			name:   "rows with top-level panels",
+			result: ResultSuccess,
+			rows: []Row{
+				{Title: "Overview", Panels: []Panel{{Type: "graph", Title: "CPU"}}},
+			},
+			panels: []Panel{{Type: "timeseries", Title: "Memory"}},
+		},
+		{
+			name: "mixed",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test' mixes the deprecated 'rows' layout, with 1 rows, and the 'panels' layout, with 2 row panels",
+			},
+			rows: []Row{
+				{Title: "Overview", Panels: []Panel{{Type: "graph", Title: "CPU"}}},
+			},
+			panels: []Panel{
+				{Type: "row", Title: "Memory"},
+				{Type: "timeseries", Title: "Memory"},
+				{Type: "row", Title: "Disk"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, linter, Dashboard{Title: "test", Rows: tc.rows, Panels: tc.panels}, tc.result)
+		})
+	}
+}
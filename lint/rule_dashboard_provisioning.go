@@ -0,0 +1,20 @@
+package lint
+
+// NewProvisioningCleanlinessRule is not part of the default rule set, as dashboards shared for importing through
+// the Grafana UI are expected to contain the export blocks.
+func NewProvisioningCleanlinessRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-provisioning-rule",
+		description: "Checks that provisioned dashboards do not contain the __inputs or __requires export blocks.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			if d.Inputs != nil {
+				r.AddError(d, "contains an '__inputs' block, which breaks provisioning")
+			}
+			if d.Requires != nil {
+				r.AddError(d, "contains a '__requires' block, which is only used when importing")
+			}
+			return r
+		},
+	}
+}
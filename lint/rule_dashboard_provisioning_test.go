@@ -0,0 +1,52 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestProvisioningCleanlinessRule(t *testing.T) {
+	linter := NewProvisioningCleanlinessRule()
+
+	for _, tc := range []struct {
+		name    string
+		results []Result
+		json    string
+	}{
+		{
+			name:    "clean",
+			results: []Result{ResultSuccess},
+			json:    `{"title": "test"}`,
+		},
+		{
+			name: "inputs",
+			results: []Result{{
+				Severity: Error,
+				Message:  "Dashboard 'test' contains an '__inputs' block, which breaks provisioning",
+			}},
+			json: `{"title": "test", "__inputs": [{"name": "DS_PROMETHEUS", "type": "datasource", "pluginId": "prometheus"}]}`,
+		},
+		{
+			name: "inputs and requires",
+			results: []Result{
+				{
+					Severity: Error,
+					Message:  "Dashboard 'test' contains an '__inputs' block, which breaks provisioning",
+				},
+				{
+					Severity: Error,
+					Message:  "Dashboard 'test' contains a '__requires' block, which is only used when importing",
+				},
+			},
+			json: `{"title": "test", "__inputs": [], "__requires": [{"type": "grafana", "id": "grafana", "version": "10.0.0"}]}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var d Dashboard
+			if err := json.Unmarshal([]byte(tc.json), &d); err != nil {
+				t.Fatal(err)
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package lint
+
+import "fmt"
+
+func NewRepeatGridPosRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-repeat-grid-pos-rule",
+		description: "Checks that no panels are placed next to horizontally repeated panels.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			// Only top level panels are laid out, panels in collapsed rows are nested in the row, and their
+			// positions are only applied when the row is expanded. Copies of repeated panels saved by older
+			// Grafana versions are replaced when the dashboard is loaded.
+			var panels []Panel
+			for _, p := range d.Panels {
+				if p.Type == panelTypeRow || p.GridPos == nil || p.RepeatPanelId != 0 {
+					continue
+				}
+				panels = append(panels, p)
+			}
+
+			// Vertical repeats push the panels below them down, so they can't overlap.
+			repeatedHorizontally := func(p Panel) bool {
+				return p.Repeat != "" && p.RepeatDirection != "v"
+			}
+			for i, p := range panels {
+				for _, other := range panels[i+1:] {
+					repeated, beside := p, other
+					if !repeatedHorizontally(repeated) {
+						repeated, beside = other, p
+						if !repeatedHorizontally(repeated) {
+							continue
+						}
+					}
+					// Horizontal repeats fill the whole width of the dashboard, from the top to the bottom of
+					// the repeated panel.
+					if beside.GridPos.Y < repeated.GridPos.Y+repeated.GridPos.H && repeated.GridPos.Y < beside.GridPos.Y+beside.GridPos.H {
+						r.AddWarning(d, fmt.Sprintf("panel '%s' is repeated horizontally for variable '%s', but panel '%s' is placed next to it at x %d, y %d, where the repeated panels may overlap it", repeated.Title, repeated.Repeat, beside.Title, beside.GridPos.X, beside.GridPos.Y))
+					}
+				}
+			}
+			return r
+		},
+	}
+}
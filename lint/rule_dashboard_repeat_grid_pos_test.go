@@ -0,0 +1,101 @@
+package lint
+
+import "testing"
+
+func TestRepeatGridPosRule(t *testing.T) {
+	linter := NewRepeatGridPosRule()
+
+	repeated := Panel{Id: 1, Type: "timeseries", Title: "bar", Repeat: "instance", GridPos: &GridPos{X: 0, Y: 8, W: 8, H: 8}}
+
+	for _, tc := range []struct {
+		name    string
+		results []Result
+		panels  []Panel
+	}{
+		{
+			name:    "alone in its row",
+			results: []Result{ResultSuccess},
+			panels: []Panel{
+				{Id: 2, Type: "stat", Title: "above", GridPos: &GridPos{X: 0, Y: 0, W: 24, H: 8}},
+				repeated,
+				{Id: 3, Type: "stat", Title: "below", GridPos: &GridPos{X: 8, Y: 16, W: 8, H: 4}},
+			},
+		},
+		{
+			name:    "vertical repeat",
+			results: []Result{ResultSuccess},
+			panels: []Panel{
+				{Id: 1, Type: "timeseries", Title: "bar", Repeat: "instance", RepeatDirection: "v", GridPos: &GridPos{X: 0, Y: 8, W: 8, H: 8}},
+				{Id: 2, Type: "stat", Title: "beside", GridPos: &GridPos{X: 8, Y: 8, W: 8, H: 8}},
+			},
+		},
+		{
+			name:    "saved copies",
+			results: []Result{ResultSuccess},
+			panels: []Panel{
+				repeated,
+				{Id: 4, Type: "timeseries", Title: "bar", RepeatPanelId: 1, GridPos: &GridPos{X: 8, Y: 8, W: 8, H: 8}},
+			},
+		},
+		{
+			name: "panel beside",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' panel 'bar' is repeated horizontally for variable 'instance', but panel 'beside' is placed next to it at x 16, y 12, where the repeated panels may overlap it",
+				},
+			},
+			panels: []Panel{
+				repeated,
+				{Id: 2, Type: "stat", Title: "beside", GridPos: &GridPos{X: 16, Y: 12, W: 8, H: 8}},
+			},
+		},
+		{
+			name: "two repeated panels",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' panel 'bar' is repeated horizontally for variable 'instance', but panel 'baz' is placed next to it at x 8, y 8, where the repeated panels may overlap it",
+				},
+			},
+			panels: []Panel{
+				repeated,
+				{Id: 2, Type: "timeseries", Title: "baz", Repeat: "job", GridPos: &GridPos{X: 8, Y: 8, W: 8, H: 8}},
+			},
+		},
+		{
+			name: "panel before",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' panel 'bar' is repeated horizontally for variable 'instance', but panel 'before' is placed next to it at x 16, y 4, where the repeated panels may overlap it",
+				},
+			},
+			panels: []Panel{
+				{Id: 2, Type: "stat", Title: "before", GridPos: &GridPos{X: 16, Y: 4, W: 8, H: 8}},
+				repeated,
+			},
+		},
+		{
+			name:    "collapsed row",
+			results: []Result{ResultSuccess},
+			panels: []Panel{
+				{
+					Id:      5,
+					Type:    "row",
+					Title:   "details",
+					GridPos: &GridPos{X: 0, Y: 0, W: 24, H: 1},
+					Panels: []Panel{
+						repeated,
+						{Id: 2, Type: "stat", Title: "beside", GridPos: &GridPos{X: 16, Y: 8, W: 8, H: 8}},
+					},
+				},
+				{Id: 3, Type: "stat", Title: "below", GridPos: &GridPos{X: 8, Y: 8, W: 8, H: 4}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testMultiResultRule(t, linter, Dashboard{Title: "test", Panels: tc.panels}, tc.results)
+		})
+	}
+}
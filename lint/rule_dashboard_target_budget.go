@@ -0,0 +1,30 @@
+package lint
+
+import "fmt"
+
+func NewDashboardTargetBudgetRule() *DashboardRuleFunc {
+	return NewDashboardTargetBudgetRuleWithThreshold(100)
+}
+
+// NewDashboardTargetBudgetRuleWithThreshold is like NewDashboardTargetBudgetRule, but allows the maximum
+// number of targets to be configured.
+func NewDashboardTargetBudgetRuleWithThreshold(budget int) *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-target-budget-rule",
+		description: "Checks that the dashboard does not have too many targets in total.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			total := 0
+			for _, p := range d.GetPanels() {
+				if p.Type != panelTypeRow {
+					total += len(p.Targets)
+				}
+			}
+			if total > budget {
+				r.AddWarning(d, fmt.Sprintf("has %d targets, which exceeds the budget of %d, consider splitting it into several dashboards", total, budget))
+			}
+			return r
+		},
+	}
+}
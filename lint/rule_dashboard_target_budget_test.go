@@ -0,0 +1,49 @@
+package lint
+
+import "testing"
+
+func TestDashboardTargetBudgetRule(t *testing.T) {
+	panels := func(n, targets int) []Panel {
+		var ps []Panel
+		for i := 0; i < n; i++ {
+			ps = append(ps, Panel{Type: "timeseries", Title: "bar", Targets: make([]Target, targets)})
+		}
+		return ps
+	}
+
+	for _, tc := range []struct {
+		name   string
+		linter *DashboardRuleFunc
+		result Result
+		panels []Panel
+	}{
+		{
+			name:   "within budget",
+			linter: NewDashboardTargetBudgetRule(),
+			result: ResultSuccess,
+			panels: panels(50, 2),
+		},
+		{
+			name:   "over budget",
+			linter: NewDashboardTargetBudgetRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has 102 targets, which exceeds the budget of 100, consider splitting it into several dashboards",
+			},
+			panels: panels(34, 3),
+		},
+		{
+			name:   "nested in rows",
+			linter: NewDashboardTargetBudgetRuleWithThreshold(4),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has 5 targets, which exceeds the budget of 4, consider splitting it into several dashboards",
+			},
+			panels: append(panels(1, 2), Panel{Type: "row", Title: "row", Panels: panels(1, 3)}),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, tc.linter, Dashboard{Title: "test", Panels: tc.panels}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+func NewTimezoneRule() *DashboardRuleFunc {
+	return NewTimezoneRuleWithAllowed("browser", "utc")
+}
+
+// NewTimezoneRuleWithAllowed is like NewTimezoneRule, but allows the accepted timezones to be configured.
+// An unset timezone is treated as "browser".
+func NewTimezoneRuleWithAllowed(allowed ...string) *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-timezone-rule",
+		description: "Checks that the dashboard timezone is not pinned to a specific zone.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			timezone := d.Timezone
+			if timezone == "" {
+				timezone = "browser"
+			}
+			for _, tz := range allowed {
+				if strings.EqualFold(timezone, tz) {
+					return r
+				}
+			}
+			r.AddWarning(d, fmt.Sprintf("has timezone '%s', it should be one of '%s'", d.Timezone, strings.Join(allowed, "', '")))
+			return r
+		},
+	}
+}
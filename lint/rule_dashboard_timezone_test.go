@@ -0,0 +1,57 @@
+package lint
+
+import "testing"
+
+func TestTimezoneRule(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		linter   Rule
+		result   Result
+		timezone string
+	}{
+		{
+			name:   "unset",
+			linter: NewTimezoneRule(),
+			result: ResultSuccess,
+		},
+		{
+			name:     "browser",
+			linter:   NewTimezoneRule(),
+			result:   ResultSuccess,
+			timezone: "browser",
+		},
+		{
+			name:     "utc",
+			linter:   NewTimezoneRule(),
+			result:   ResultSuccess,
+			timezone: "utc",
+		},
+		{
+			name:   "specific zone",
+			linter: NewTimezoneRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has timezone 'America/New_York', it should be one of 'browser', 'utc'",
+			},
+			timezone: "America/New_York",
+		},
+		{
+			name:     "configured zone",
+			linter:   NewTimezoneRuleWithAllowed("utc", "Europe/Berlin"),
+			result:   ResultSuccess,
+			timezone: "Europe/Berlin",
+		},
+		{
+			name:   "configured zone but unset",
+			linter: NewTimezoneRuleWithAllowed("utc"),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has timezone '', it should be one of 'utc'",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, tc.linter, Dashboard{Title: "test", Timezone: tc.timezone}, tc.result)
+		})
+	}
+}
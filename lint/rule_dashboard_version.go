@@ -0,0 +1,17 @@
+package lint
+
+import "fmt"
+
+func NewDashboardVersionRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "dashboard-version-rule",
+		description: "Checks that the dashboard version is not committed to source control.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			if d.Version != 0 {
+				r.AddWarning(d, fmt.Sprintf("has version '%d', it should be removed or set to 0 in source control", d.Version))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package lint
+
+import "testing"
+
+func TestDashboardVersionRule(t *testing.T) {
+	linter := NewDashboardVersionRule()
+
+	for _, tc := range []struct {
+		name    string
+		result  Result
+		version int
+	}{
+		{
+			name:   "unset",
+			result: ResultSuccess,
+		},
+		{
+			name: "set",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has version '12', it should be removed or set to 0 in source control",
+			},
+			version: 12,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, linter, Dashboard{Title: "test", Version: tc.version}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package lint
+
+import "fmt"
+
+// angularPanelPlugins are panel plugins which are only available as AngularJS plugins. Core panels, such as
+// graph and singlestat, are not included, as Grafana migrates them automatically.
+var angularPanelPlugins = []string{
+	"grafana-piechart-panel",
+	"grafana-worldmap-panel",
+	"natel-discrete-panel",
+	"natel-plotly-panel",
+	"briangann-gauge-panel",
+	"briangann-datatable-panel",
+	"vonage-status-panel",
+	"flant-statusmap-panel",
+	"michaeldmoore-annunciator-panel",
+	"petrslavotinek-carpetplot-panel",
+	"jdbranham-diagram-panel",
+	"btplc-status-dot-panel",
+	"mxswat-separator-panel",
+	"savantly-heatmap-panel",
+	"neocat-cal-heatmap-panel",
+	"agenty-flowcharting-panel",
+	"yesoreyeram-boomtable-panel",
+	"digrich-bubblechart-panel",
+	"ryantxu-ajax-panel",
+	"snuids-trafficlights-panel",
+	"corpglory-progresslist-panel",
+}
+
+func NewAngularPanelRule() *PanelRuleFunc {
+	return NewAngularPanelRuleWithPlugins(angularPanelPlugins...)
+}
+
+// NewAngularPanelRuleWithPlugins is like NewAngularPanelRule, but allows the panel plugin ids which are
+// reported as AngularJS plugins to be configured.
+func NewAngularPanelRuleWithPlugins(plugins ...string) *PanelRuleFunc {
+	angular := make(map[string]struct{}, len(plugins))
+	for _, plugin := range plugins {
+		angular[plugin] = struct{}{}
+	}
+
+	return &PanelRuleFunc{
+		name:        "panel-angular-rule",
+		description: "Checks that panels do not use deprecated AngularJS panel plugins.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if _, ok := angular[p.Type]; ok {
+				r.AddError(d, p, fmt.Sprintf("uses AngularJS panel plugin '%s', which is deprecated and being removed from Grafana, migrate to a supported panel", p.Type))
+			}
+			return r
+		},
+	}
+}
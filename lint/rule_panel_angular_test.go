@@ -0,0 +1,51 @@
+package lint
+
+import "testing"
+
+func TestAngularPanelRule(t *testing.T) {
+	linter := NewAngularPanelRule()
+
+	for _, tc := range []struct {
+		result    Result
+		panelType string
+	}{
+		{
+			result:    ResultSuccess,
+			panelType: "piechart",
+		},
+		{
+			result:    ResultSuccess,
+			panelType: "graph",
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' uses AngularJS panel plugin 'grafana-piechart-panel', which is deprecated and being removed from Grafana, migrate to a supported panel",
+			},
+			panelType: "grafana-piechart-panel",
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' uses AngularJS panel plugin 'natel-discrete-panel', which is deprecated and being removed from Grafana, migrate to a supported panel",
+			},
+			panelType: "natel-discrete-panel",
+		},
+	} {
+		panel := Panel{Type: tc.panelType, Title: "bar"}
+		testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+	}
+}
+
+func TestAngularPanelRuleWithPlugins(t *testing.T) {
+	linter := NewAngularPanelRuleWithPlugins("acme-legacy-panel")
+
+	panel := Panel{Type: "grafana-piechart-panel", Title: "bar"}
+	testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, ResultSuccess)
+
+	panel = Panel{Type: "acme-legacy-panel", Title: "bar"}
+	testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, Result{
+		Severity: Error,
+		Message:  "Dashboard 'test', panel 'bar' uses AngularJS panel plugin 'acme-legacy-panel', which is deprecated and being removed from Grafana, migrate to a supported panel",
+	})
+}
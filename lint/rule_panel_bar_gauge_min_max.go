@@ -0,0 +1,31 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+func NewBarGaugeMinMaxRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-bar-gauge-min-max-rule",
+		description: "Checks that bar gauge panels set min and max.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeBarGauge {
+				return r
+			}
+
+			var unset []string
+			if p.FieldConfig == nil || p.FieldConfig.Defaults.Min == nil {
+				unset = append(unset, "min")
+			}
+			if p.FieldConfig == nil || p.FieldConfig.Defaults.Max == nil {
+				unset = append(unset, "max")
+			}
+			if len(unset) > 0 {
+				r.AddWarning(d, p, fmt.Sprintf("does not set %s, so the bars are scaled to the current values", strings.Join(unset, " and ")))
+			}
+			return r
+		},
+	}
+}
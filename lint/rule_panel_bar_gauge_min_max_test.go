@@ -0,0 +1,53 @@
+package lint
+
+import "testing"
+
+func TestBarGaugeMinMaxRule(t *testing.T) {
+	linter := NewBarGaugeMinMaxRule()
+	zero, hundred := 0.0, 100.0
+
+	for _, tc := range []struct {
+		name        string
+		result      Result
+		panelType   string
+		fieldConfig *FieldConfig
+	}{
+		{
+			name:        "min and max",
+			result:      ResultSuccess,
+			panelType:   "bargauge",
+			fieldConfig: &FieldConfig{Defaults: Defaults{Min: &zero, Max: &hundred}},
+		},
+		{
+			name:      "not a bar gauge",
+			result:    ResultSuccess,
+			panelType: "stat",
+		},
+		{
+			name: "no field config",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' does not set min and max, so the bars are scaled to the current values",
+			},
+			panelType: "bargauge",
+		},
+		{
+			name: "no max",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' does not set max, so the bars are scaled to the current values",
+			},
+			panelType:   "bargauge",
+			fieldConfig: &FieldConfig{Defaults: Defaults{Min: &zero}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:        tc.panelType,
+				Title:       "bar",
+				FieldConfig: tc.fieldConfig,
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxCurrencyDecimals is the largest number of decimals which makes sense for a currency amount.
+const maxCurrencyDecimals = 2
+
+func NewCurrencyPrecisionRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-currency-precision-rule",
+		description: "Checks that panels using currency units set a sensible number of decimals.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+
+			unit := getConfiguredUnit(p)
+			if !strings.HasPrefix(unit, "currency") {
+				return r
+			}
+
+			// A currency unit implies a non-nil FieldConfig.
+			decimals := p.FieldConfig.Defaults.Decimals
+			if decimals == nil {
+				r.AddWarning(d, p, fmt.Sprintf("uses currency unit '%s' but does not set decimals", unit))
+			} else if *decimals > maxCurrencyDecimals {
+				r.AddWarning(d, p, fmt.Sprintf("uses currency unit '%s' with %d decimals, should use at most %d", unit, *decimals, maxCurrencyDecimals))
+			}
+			return r
+		},
+	}
+}
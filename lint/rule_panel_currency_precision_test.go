@@ -0,0 +1,121 @@
+package lint
+
+import "testing"
+
+func TestCurrencyPrecisionRule(t *testing.T) {
+	linter := NewCurrencyPrecisionRule()
+	zero, two, six := 0, 2, 6
+
+	for _, tc := range []struct {
+		name   string
+		result Result
+		panel  Panel
+	}{
+		{
+			name:   "non-currency unit",
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "stat",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{Unit: "bytes"},
+				},
+			},
+		},
+		{
+			name: "decimals unset",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' uses currency unit 'currencyUSD' but does not set decimals",
+			},
+			panel: Panel{
+				Type:  "stat",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{Unit: "currencyUSD"},
+				},
+			},
+		},
+		{
+			name: "too many decimals",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' uses currency unit 'currencyEUR' with 6 decimals, should use at most 2",
+			},
+			panel: Panel{
+				Type:  "stat",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{Unit: "currencyEUR", Decimals: &six},
+				},
+			},
+		},
+		{
+			name: "unit from override",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' uses currency unit 'currencyGBP' but does not set decimals",
+			},
+			panel: Panel{
+				Type:  "stat",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Overrides: []Override{
+						{
+							OverrideProperties: []OverrideProperty{
+								{Id: "unit", Value: "currencyGBP"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "null unit override",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' uses currency unit 'currencyUSD' but does not set decimals",
+			},
+			panel: Panel{
+				Type:  "stat",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{Unit: "currencyUSD"},
+					Overrides: []Override{
+						{
+							OverrideProperties: []OverrideProperty{
+								{Id: "unit", Value: nil},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "zero decimals",
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "stat",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{Unit: "currencyJPY", Decimals: &zero},
+				},
+			},
+		},
+		{
+			name:   "two decimals",
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "stat",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{Unit: "currencyUSD", Decimals: &two},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{tc.panel}}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// dashboardUIDRegexp matches the uids Grafana accepts for dashboards.
+var dashboardUIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,40}$`)
+
+// NewPanelDashboardLinkRule checks links against the other dashboards in the LintSet being linted. Without
+// a LintSet, only the syntax of the linked uids is checked.
+func NewPanelDashboardLinkRule() *PanelContextRuleFunc {
+	return newPanelDashboardLinkRule(nil)
+}
+
+// NewPanelDashboardLinkRuleWithUIDs is like NewPanelDashboardLinkRule, but also accepts links to the given
+// uids, e.g. dashboards which are not linted alongside this one.
+func NewPanelDashboardLinkRuleWithUIDs(uids ...string) *PanelContextRuleFunc {
+	known := make(map[string]struct{}, len(uids))
+	for _, uid := range uids {
+		known[uid] = struct{}{}
+	}
+	return newPanelDashboardLinkRule(known)
+}
+
+func newPanelDashboardLinkRule(known map[string]struct{}) *PanelContextRuleFunc {
+	return &PanelContextRuleFunc{
+		name:        "panel-dashboard-link-rule",
+		description: "Checks that panel links to other dashboards point at a valid dashboard uid.",
+		fn: func(d Dashboard, p Panel, dashboards map[string]*Dashboard) PanelRuleResults {
+			r := PanelRuleResults{}
+			for _, link := range p.Links {
+				uid, ok := link.DashboardUID()
+				if !ok || strings.Contains(uid, "$") {
+					// Links built from variables can't be resolved statically.
+					continue
+				}
+				if !dashboardUIDRegexp.MatchString(uid) {
+					r.AddWarning(d, p, fmt.Sprintf("links to dashboard uid '%s', which is not a valid uid", uid))
+					continue
+				}
+				if known == nil && dashboards == nil {
+					continue
+				}
+				if _, ok := known[uid]; ok {
+					continue
+				}
+				if _, ok := dashboards[uid]; !ok {
+					r.AddError(d, p, fmt.Sprintf("links to dashboard uid '%s', which does not exist", uid))
+				}
+			}
+			return r
+		},
+	}
+}
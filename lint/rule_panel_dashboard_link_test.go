@@ -0,0 +1,76 @@
+package lint
+
+import "testing"
+
+func TestPanelDashboardLinkRule(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		linter Rule
+		result Result
+		links  []Link
+	}{
+		{
+			name:   "no links",
+			linter: NewPanelDashboardLinkRule(),
+			result: ResultSuccess,
+		},
+		{
+			name:   "valid uids",
+			linter: NewPanelDashboardLinkRule(),
+			result: ResultSuccess,
+			links: []Link{
+				{Title: "Details", Type: "dashboard", Dashboard: "service-details"},
+				{Title: "Overview", Url: "/d/abc_123/overview?var-job=${job}"},
+				{Title: "Docs", Url: "https://example.com/docs"},
+			},
+		},
+		{
+			name:   "variable uid",
+			linter: NewPanelDashboardLinkRule(),
+			result: ResultSuccess,
+			links:  []Link{{Title: "Details", Url: "/d/${dashboard}"}},
+		},
+		{
+			name:   "malformed uid",
+			linter: NewPanelDashboardLinkRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' links to dashboard uid 'service details', which is not a valid uid",
+			},
+			links: []Link{{Title: "Details", Type: "dashboard", Dashboard: "service details"}},
+		},
+		{
+			name:   "empty uid",
+			linter: NewPanelDashboardLinkRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' links to dashboard uid '', which is not a valid uid",
+			},
+			links: []Link{{Title: "Details", Type: "dashboard"}},
+		},
+		{
+			name:   "known uid",
+			linter: NewPanelDashboardLinkRuleWithUIDs("service-details"),
+			result: ResultSuccess,
+			links:  []Link{{Title: "Details", Url: "https://grafana.example.com/d/service-details/details"}},
+		},
+		{
+			name:   "unknown uid",
+			linter: NewPanelDashboardLinkRuleWithUIDs("service-details"),
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' links to dashboard uid 'service-overview', which does not exist",
+			},
+			links: []Link{{Title: "Overview", Type: "dashboard", Dashboard: "service-overview"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:  "timeseries",
+				Title: "bar",
+				Links: tc.links,
+			}
+			testRule(t, tc.linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
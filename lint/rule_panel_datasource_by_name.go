@@ -0,0 +1,54 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// defaultDatasourceNames are the names Grafana gives new datasources of the common types. Uids can't
+// otherwise be told apart from names by their case, as generated short uids are mixed case, e.g. "Ha8gVv4Vk".
+var defaultDatasourceNames = map[string]bool{
+	"Prometheus":    true,
+	"Loki":          true,
+	"Tempo":         true,
+	"Jaeger":        true,
+	"Zipkin":        true,
+	"Graphite":      true,
+	"InfluxDB":      true,
+	"Elasticsearch": true,
+	"MySQL":         true,
+	"PostgreSQL":    true,
+	"CloudWatch":    true,
+	"Mimir":         true,
+}
+
+func NewDatasourceByNameRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-datasource-by-name-rule",
+		description: "Checks that panels and targets reference datasources by uid rather than by name.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+
+			if src, err := p.GetDataSource(); err == nil && isDatasourceName(src.UID) {
+				r.AddWarning(d, p, fmt.Sprintf("references datasource '%s', which looks like a name rather than a uid", src.UID))
+			}
+			for _, t := range p.Targets {
+				// Invalid datasources are reported by other rules.
+				if src, err := t.GetDataSource(); err == nil && isDatasourceName(src.UID) {
+					r.AddWarning(d, p, fmt.Sprintf("target refId '%s' references datasource '%s', which looks like a name rather than a uid", t.RefId, src.UID))
+				}
+			}
+			return r
+		},
+	}
+}
+
+func isDatasourceName(uid string) bool {
+	// Skip variables, and the special "-- Mixed --", "-- Dashboard --" and "-- Grafana --" datasources.
+	if uid == "" || strings.Contains(uid, "$") || strings.HasPrefix(uid, "--") {
+		return false
+	}
+	// Uids can't contain whitespace.
+	return strings.IndexFunc(uid, unicode.IsSpace) >= 0 || defaultDatasourceNames[uid]
+}
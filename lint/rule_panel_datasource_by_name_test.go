@@ -0,0 +1,62 @@
+package lint
+
+import "testing"
+
+func TestDatasourceByNameRule(t *testing.T) {
+	linter := NewDatasourceByNameRule()
+
+	for _, tc := range []struct {
+		name    string
+		results []Result
+		panel   Panel
+	}{
+		{
+			name:    "uids",
+			results: []Result{ResultSuccess},
+			panel: Panel{
+				Datasource: map[string]interface{}{"type": "prometheus", "uid": "P1809F7CD0C75ACF3"},
+				Targets: []Target{
+					{RefId: "A", Datasource: map[string]interface{}{"type": "prometheus", "uid": "be5ch3j6k8oaoe"}},
+					{RefId: "B", Datasource: map[string]interface{}{"type": "loki", "uid": "Ha8gVv4Vk"}},
+				},
+			},
+		},
+		{
+			name:    "variables and special datasources",
+			results: []Result{ResultSuccess},
+			panel: Panel{
+				Datasource: map[string]interface{}{"uid": "-- Mixed --"},
+				Targets: []Target{
+					{RefId: "A", Datasource: "${datasource}"},
+					{RefId: "B", Datasource: map[string]interface{}{"uid": "-- Grafana --"}},
+				},
+			},
+		},
+		{
+			name: "names",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar' references datasource 'Prometheus', which looks like a name rather than a uid",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar' target refId 'B' references datasource 'prod metrics', which looks like a name rather than a uid",
+				},
+			},
+			panel: Panel{
+				Datasource: "Prometheus",
+				Targets: []Target{
+					{RefId: "A", Datasource: "$datasource"},
+					{RefId: "B", Datasource: map[string]interface{}{"uid": "prod metrics"}},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.panel.Type = "timeseries"
+			tc.panel.Title = "bar"
+			testMultiResultRule(t, linter, Dashboard{Title: "test", Panels: []Panel{tc.panel}}, tc.results)
+		})
+	}
+}
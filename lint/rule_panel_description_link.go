@@ -0,0 +1,44 @@
+package lint
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	markdownLinkRegexp  = regexp.MustCompile(`\[([^\]]*)\]\(([^)]*)\)`)
+	missingSchemeRegexp = regexp.MustCompile(`(?i)^https?//`)
+)
+
+func NewDescriptionLinkRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-description-link-rule",
+		description: "Checks that markdown links in panel descriptions have a well-formed URL.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			for _, match := range markdownLinkRegexp.FindAllStringSubmatch(p.Description, -1) {
+				if !isWellFormedLink(strings.TrimSpace(match[2])) {
+					r.AddWarning(d, p, fmt.Sprintf("description has link '%s' with an empty or malformed URL", match[0]))
+				}
+			}
+			return r
+		},
+	}
+}
+
+// isWellFormedLink only checks the syntax of a link, whether it can be reached is out of scope.
+func isWellFormedLink(link string) bool {
+	if link == "" || missingSchemeRegexp.MatchString(link) {
+		return false
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	if (u.Scheme == "http" || u.Scheme == "https") && u.Host == "" {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package lint
+
+import "testing"
+
+func TestDescriptionLinkRule(t *testing.T) {
+	linter := NewDescriptionLinkRule()
+
+	for _, tc := range []struct {
+		result      Result
+		description string
+	}{
+		{
+			result:      ResultSuccess,
+			description: "Requests per second.",
+		},
+		{
+			result:      ResultSuccess,
+			description: "See the [runbook](https://example.com/runbooks/latency#high) for details.",
+		},
+		{
+			result:      ResultSuccess,
+			description: "See [the other dashboard](/d/abc123/overview) and [below](#details).",
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' description has link '[runbook]()' with an empty or malformed URL",
+			},
+			description: "See the [runbook]() for details.",
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' description has link '[runbook](http//example.com/runbook)' with an empty or malformed URL",
+			},
+			description: "See the [runbook](http//example.com/runbook) for details.",
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' description has link '[runbook](https:/example.com)' with an empty or malformed URL",
+			},
+			description: "[runbook](https:/example.com)",
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' description has link '[docs](http://exa mple.com/%zz)' with an empty or malformed URL",
+			},
+			description: "[docs](http://exa mple.com/%zz)",
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Panels: []Panel{
+				{
+					Type:        "timeseries",
+					Title:       "bar",
+					Description: tc.description,
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
@@ -0,0 +1,17 @@
+package lint
+
+import "fmt"
+
+func NewDescriptionVariableRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-description-variable-rule",
+		description: "Checks that variables referenced in panel descriptions exist.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			for _, name := range unknownVariables(p.Description, d.Templating.List) {
+				r.AddWarning(d, p, fmt.Sprintf("description references unknown variable '%s'", name))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package lint
+
+import "testing"
+
+func TestDescriptionVariableRule(t *testing.T) {
+	linter := NewDescriptionVariableRule()
+
+	for _, tc := range []struct {
+		result Result
+		panel  Panel
+	}{
+		{
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "CPU",
+			},
+		},
+		{
+			result: ResultSuccess,
+			panel: Panel{
+				Type:        "timeseries",
+				Title:       "CPU",
+				Description: "CPU usage of ${cluster} over $__range.",
+			},
+		},
+		{
+			result: ResultSuccess,
+			panel: Panel{
+				Type:        "stat",
+				Title:       "Cost",
+				Description: "Costs above $5 are highlighted.",
+			},
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'CPU' description references unknown variable 'region'",
+			},
+			panel: Panel{
+				Type:        "timeseries",
+				Title:       "CPU",
+				Description: "CPU usage of $cluster in $region.",
+			},
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'CPU' description references unknown variable 'node'",
+			},
+			panel: Panel{
+				Type:        "timeseries",
+				Title:       "CPU",
+				Description: "CPU usage of ${node:csv}.",
+			},
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{
+					{
+						Name: "cluster",
+						Type: "query",
+					},
+				},
+			},
+			Panels: []Panel{tc.panel},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
@@ -0,0 +1,33 @@
+package lint
+
+import "fmt"
+
+func NewDuplicateTargetRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-duplicate-target-rule",
+		description: "Checks that a panel does not contain multiple targets with the same expression.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+
+			// Targets are compared by their normalized expression, but reported as first written.
+			first := map[string]string{}
+			reported := map[string]bool{}
+			for _, t := range p.Targets {
+				expr := normalizeExpr(t.Expr, d.Templating.List)
+				if expr == "" {
+					continue
+				}
+				written, ok := first[expr]
+				if !ok {
+					first[expr] = collapseWhitespace(t.Expr)
+					continue
+				}
+				if !reported[expr] {
+					r.AddWarning(d, p, fmt.Sprintf("has duplicate targets with expression '%s'", written))
+					reported[expr] = true
+				}
+			}
+			return r
+		},
+	}
+}
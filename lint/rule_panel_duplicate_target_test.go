@@ -0,0 +1,87 @@
+package lint
+
+import "testing"
+
+func TestDuplicateTargetRule(t *testing.T) {
+	linter := NewDuplicateTargetRule()
+
+	for _, tc := range []struct {
+		name    string
+		result  Result
+		targets []Target
+	}{
+		{
+			name:   "distinct",
+			result: ResultSuccess,
+			targets: []Target{
+				{RefId: "A", Expr: `sum(rate(foo_total[5m]))`},
+				{RefId: "B", Expr: `sum(rate(bar_total[5m]))`},
+			},
+		},
+		{
+			name:   "empty expressions",
+			result: ResultSuccess,
+			targets: []Target{
+				{RefId: "A"},
+				{RefId: "B"},
+			},
+		},
+		{
+			name: "identical",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has duplicate targets with expression 'sum(rate(foo_total[5m]))'",
+			},
+			targets: []Target{
+				{RefId: "A", Expr: `sum(rate(foo_total[5m]))`},
+				{RefId: "B", Expr: `sum(rate(foo_total[5m]))`},
+			},
+		},
+		{
+			name: "whitespace differences",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has duplicate targets with expression 'sum by (job) (up)'",
+			},
+			targets: []Target{
+				{RefId: "A", Expr: `sum by (job) (up)`},
+				{RefId: "B", Expr: "sum  by (job)\n  (up)"},
+				{RefId: "C", Expr: ` sum by (job) (up) `},
+			},
+		},
+		{
+			name: "grouping clause position",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has duplicate targets with expression 'sum(rate(foo_total[5m])) by (job)'",
+			},
+			targets: []Target{
+				{RefId: "A", Expr: `sum(rate(foo_total[5m])) by (job)`},
+				{RefId: "B", Expr: `sum by (job) (rate(foo_total[5m]))`},
+				{RefId: "C", Expr: "sum by(job)(\n  rate(foo_total[5m])\n)"},
+			},
+		},
+		{
+			name:   "different grouping",
+			result: ResultSuccess,
+			targets: []Target{
+				{RefId: "A", Expr: `sum by (job) (rate(foo_total[5m]))`},
+				{RefId: "B", Expr: `sum by (instance) (rate(foo_total[5m]))`},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Panels: []Panel{
+					{
+						Type:    "timeseries",
+						Title:   "bar",
+						Targets: tc.targets,
+					},
+				},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
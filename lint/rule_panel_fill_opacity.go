@@ -0,0 +1,35 @@
+package lint
+
+import "fmt"
+
+// maxFillOpacity is the largest fill opacity which keeps overlapping series readable.
+const maxFillOpacity = 50
+
+func NewFillOpacityRule() *PanelRuleFunc {
+	return NewFillOpacityRuleWithThreshold(10)
+}
+
+// NewFillOpacityRuleWithThreshold is like NewFillOpacityRule, but allows the number of series above which
+// a high fill opacity is reported to be configured.
+func NewFillOpacityRuleWithThreshold(series int) *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-fill-opacity-rule",
+		description: "Checks that timeseries panels with many series do not use a high fill opacity.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeTimeSeries || p.FieldConfig == nil || p.FieldConfig.Defaults.Custom == nil {
+				return r
+			}
+
+			opacity := p.FieldConfig.Defaults.Custom.FillOpacity
+			if opacity == nil || *opacity <= maxFillOpacity {
+				return r
+			}
+
+			if estimateSeriesCount(d, p) >= series {
+				r.AddWarning(d, p, fmt.Sprintf("has fill opacity %g and may return many series, fill opacity should be at most %d", *opacity, maxFillOpacity))
+			}
+			return r
+		},
+	}
+}
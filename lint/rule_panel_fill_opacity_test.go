@@ -0,0 +1,64 @@
+package lint
+
+import "testing"
+
+func TestFillOpacityRule(t *testing.T) {
+	low, high := 10.0, 100.0
+
+	for _, tc := range []struct {
+		name    string
+		linter  Rule
+		result  Result
+		opacity *float64
+		targets []Target
+	}{
+		{
+			name:    "low opacity",
+			linter:  NewFillOpacityRule(),
+			result:  ResultSuccess,
+			opacity: &low,
+			targets: []Target{{Expr: `rate(foo_total[5m])`}},
+		},
+		{
+			name:    "high opacity, single series",
+			linter:  NewFillOpacityRule(),
+			result:  ResultSuccess,
+			opacity: &high,
+			targets: []Target{{Expr: `sum(rate(foo_total[5m]))`}},
+		},
+		{
+			name:   "high opacity, many series",
+			linter: NewFillOpacityRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has fill opacity 100 and may return many series, fill opacity should be at most 50",
+			},
+			opacity: &high,
+			targets: []Target{{Expr: `sum by (pod) (rate(foo_total[5m]))`}},
+		},
+		{
+			name:   "high opacity, configured threshold",
+			linter: NewFillOpacityRuleWithThreshold(2),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has fill opacity 100 and may return many series, fill opacity should be at most 50",
+			},
+			opacity: &high,
+			targets: []Target{{Expr: `sum(up)`}, {Expr: `sum(foo)`}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:    "timeseries",
+				Title:   "bar",
+				Targets: tc.targets,
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{
+						Custom: &FieldCustom{FillOpacity: tc.opacity},
+					},
+				},
+			}
+			testRule(t, tc.linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package lint
+
+import "fmt"
+
+func NewFixedColorRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-fixed-color-rule",
+		description: "Checks that panels only set a fixed color when the color mode is fixed.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.FieldConfig == nil || p.FieldConfig.Defaults.Color == nil {
+				return r
+			}
+
+			color := p.FieldConfig.Defaults.Color
+			if color.FixedColor != "" && color.Mode != "fixed" {
+				r.AddWarning(d, p, fmt.Sprintf("sets fixed color '%s', but color mode '%s' ignores it", color.FixedColor, color.Mode))
+			}
+			return r
+		},
+	}
+}
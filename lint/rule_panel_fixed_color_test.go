@@ -0,0 +1,45 @@
+package lint
+
+import "testing"
+
+func TestFixedColorRule(t *testing.T) {
+	linter := NewFixedColorRule()
+
+	for _, tc := range []struct {
+		name   string
+		result Result
+		color  *FieldColor
+	}{
+		{
+			name:   "no color",
+			result: ResultSuccess,
+		},
+		{
+			name:   "fixed mode",
+			result: ResultSuccess,
+			color:  &FieldColor{Mode: "fixed", FixedColor: "green"},
+		},
+		{
+			name:   "palette mode",
+			result: ResultSuccess,
+			color:  &FieldColor{Mode: "palette-classic"},
+		},
+		{
+			name: "palette mode with fixed color",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' sets fixed color 'green', but color mode 'palette-classic' ignores it",
+			},
+			color: &FieldColor{Mode: "palette-classic", FixedColor: "green"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:        "timeseries",
+				Title:       "bar",
+				FieldConfig: &FieldConfig{Defaults: Defaults{Color: tc.color}},
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
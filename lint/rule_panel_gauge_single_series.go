@@ -0,0 +1,19 @@
+package lint
+
+func NewGaugeSingleSeriesRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-gauge-single-series-rule",
+		description: "Checks that gauge panels query a single series.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeGauge {
+				return r
+			}
+
+			if estimateSeriesCount(d, p) > 1 {
+				r.AddWarning(d, p, "may return more than one series, which renders a gauge for each, aggregate the query to a single series")
+			}
+			return r
+		},
+	}
+}
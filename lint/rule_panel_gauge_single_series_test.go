@@ -0,0 +1,75 @@
+package lint
+
+import "testing"
+
+func TestGaugeSingleSeriesRule(t *testing.T) {
+	linter := NewGaugeSingleSeriesRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		targets   []Target
+	}{
+		{
+			name:      "aggregated",
+			result:    ResultSuccess,
+			panelType: "gauge",
+			targets:   []Target{{Expr: `sum(rate(http_requests_total[5m]))`}},
+		},
+		{
+			name:      "hidden target",
+			result:    ResultSuccess,
+			panelType: "gauge",
+			targets: []Target{
+				{Expr: `sum(up)`},
+				{Expr: `up`, Hide: true},
+			},
+		},
+		{
+			name:      "not a gauge",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			targets:   []Target{{Expr: `up`}},
+		},
+		{
+			name: "not aggregated",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' may return more than one series, which renders a gauge for each, aggregate the query to a single series",
+			},
+			panelType: "gauge",
+			targets:   []Target{{Expr: `rate(http_requests_total[5m])`}},
+		},
+		{
+			name: "aggregated by label",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' may return more than one series, which renders a gauge for each, aggregate the query to a single series",
+			},
+			panelType: "gauge",
+			targets:   []Target{{Expr: `sum by (job) (rate(http_requests_total[5m]))`}},
+		},
+		{
+			name: "several targets",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' may return more than one series, which renders a gauge for each, aggregate the query to a single series",
+			},
+			panelType: "gauge",
+			targets: []Target{
+				{Expr: `sum(up)`},
+				{Expr: `count(up)`},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:    tc.panelType,
+				Title:   "bar",
+				Targets: tc.targets,
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
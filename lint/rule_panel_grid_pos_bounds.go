@@ -0,0 +1,34 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// gridColumns is the width of the dashboard grid.
+const gridColumns = 24
+
+func NewGridPosBoundsRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-grid-pos-bounds-rule",
+		description: "Checks that panel sizes are within the bounds of the dashboard grid.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.GridPos == nil {
+				return r
+			}
+
+			var problems []string
+			if p.GridPos.W < 1 || p.GridPos.W > gridColumns {
+				problems = append(problems, fmt.Sprintf("width %d, which should be between 1 and %d", p.GridPos.W, gridColumns))
+			}
+			if p.GridPos.H <= 0 {
+				problems = append(problems, fmt.Sprintf("height %d, which should be positive", p.GridPos.H))
+			}
+			if len(problems) > 0 {
+				r.AddError(d, p, fmt.Sprintf("has gridPos %s", strings.Join(problems, " and ")))
+			}
+			return r
+		},
+	}
+}
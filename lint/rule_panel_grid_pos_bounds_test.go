@@ -0,0 +1,56 @@
+package lint
+
+import "testing"
+
+func TestGridPosBoundsRule(t *testing.T) {
+	linter := NewGridPosBoundsRule()
+
+	for _, tc := range []struct {
+		name    string
+		result  Result
+		gridPos *GridPos
+	}{
+		{
+			name:   "no gridPos",
+			result: ResultSuccess,
+		},
+		{
+			name:    "full width",
+			result:  ResultSuccess,
+			gridPos: &GridPos{X: 0, Y: 0, W: 24, H: 8},
+		},
+		{
+			name: "too wide",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' has gridPos width 25, which should be between 1 and 24",
+			},
+			gridPos: &GridPos{X: 0, Y: 0, W: 25, H: 8},
+		},
+		{
+			name: "zero height",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' has gridPos height 0, which should be positive",
+			},
+			gridPos: &GridPos{X: 0, Y: 0, W: 12, H: 0},
+		},
+		{
+			name: "zero size",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' has gridPos width 0, which should be between 1 and 24 and height -1, which should be positive",
+			},
+			gridPos: &GridPos{X: 0, Y: 0, W: 0, H: -1},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:    "timeseries",
+				Title:   "bar",
+				GridPos: tc.gridPos,
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
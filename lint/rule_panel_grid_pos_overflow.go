@@ -0,0 +1,22 @@
+package lint
+
+import "fmt"
+
+func NewGridPosOverflowRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-grid-pos-overflow-rule",
+		description: "Checks that panels do not extend past the right edge of the dashboard grid.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			// Invalid widths are reported by panel-grid-pos-bounds-rule.
+			if p.GridPos == nil || p.GridPos.W < 1 || p.GridPos.W > gridColumns {
+				return r
+			}
+
+			if p.GridPos.X+p.GridPos.W > gridColumns {
+				r.AddWarning(d, p, fmt.Sprintf("at x %d with width %d extends past the %d columns of the dashboard grid", p.GridPos.X, p.GridPos.W, gridColumns))
+			}
+			return r
+		},
+	}
+}
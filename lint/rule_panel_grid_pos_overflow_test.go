@@ -0,0 +1,45 @@
+package lint
+
+import "testing"
+
+func TestGridPosOverflowRule(t *testing.T) {
+	linter := NewGridPosOverflowRule()
+
+	for _, tc := range []struct {
+		name    string
+		result  Result
+		gridPos *GridPos
+	}{
+		{
+			name:   "no gridPos",
+			result: ResultSuccess,
+		},
+		{
+			name:    "right half",
+			result:  ResultSuccess,
+			gridPos: &GridPos{X: 12, Y: 0, W: 12, H: 8},
+		},
+		{
+			name:    "invalid width",
+			result:  ResultSuccess,
+			gridPos: &GridPos{X: 0, Y: 0, W: 30, H: 8},
+		},
+		{
+			name: "overflow",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' at x 16 with width 12 extends past the 24 columns of the dashboard grid",
+			},
+			gridPos: &GridPos{X: 16, Y: 0, W: 12, H: 8},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:    "timeseries",
+				Title:   "bar",
+				GridPos: tc.gridPos,
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
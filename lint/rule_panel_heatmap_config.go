@@ -0,0 +1,35 @@
+package lint
+
+import "encoding/json"
+
+func NewHeatmapConfigRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-heatmap-config-rule",
+		description: "Checks that heatmap panels configure their color scheme and bucketing.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeHeatmap {
+				return r
+			}
+
+			var opts HeatmapOptions
+			if len(p.Options) > 0 {
+				if err := json.Unmarshal(p.Options, &opts); err != nil {
+					// Invalid options are reported by panel-options-rule.
+					return r
+				}
+			}
+
+			// The opacity color mode doesn't use a scheme.
+			if opts.Color.Mode != "opacity" && opts.Color.Scheme == "" {
+				r.AddWarning(d, p, "does not set a color scheme")
+			}
+			if opts.Calculate == nil {
+				r.AddWarning(d, p, "does not set whether buckets are calculated from the data")
+			} else if *opts.Calculate && len(opts.Calculation) == 0 {
+				r.AddWarning(d, p, "calculates buckets from the data but does not configure the calculation")
+			}
+			return r
+		},
+	}
+}
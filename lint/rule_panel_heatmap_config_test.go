@@ -0,0 +1,55 @@
+package lint
+
+import "testing"
+
+func TestHeatmapConfigRule(t *testing.T) {
+	linter := NewHeatmapConfigRule()
+
+	for _, tc := range []struct {
+		name    string
+		result  []Result
+		options string
+	}{
+		{
+			name:    "prometheus histogram",
+			result:  []Result{ResultSuccess},
+			options: `{"calculate": false, "color": {"mode": "scheme", "scheme": "Oranges"}}`,
+		},
+		{
+			name:    "calculated buckets",
+			result:  []Result{ResultSuccess},
+			options: `{"calculate": true, "calculation": {"yBuckets": {"mode": "count", "value": "20"}}, "color": {"mode": "opacity"}}`,
+		},
+		{
+			name: "defaults",
+			result: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar' does not set a color scheme",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar' does not set whether buckets are calculated from the data",
+				},
+			},
+			options: `{}`,
+		},
+		{
+			name: "default calculation",
+			result: []Result{{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' calculates buckets from the data but does not configure the calculation",
+			}},
+			options: `{"calculate": true, "color": {"mode": "scheme", "scheme": "Oranges"}}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:    "heatmap",
+				Title:   "bar",
+				Options: []byte(tc.options),
+			}
+			testMultiResultRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
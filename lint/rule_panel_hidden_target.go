@@ -0,0 +1,30 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+func NewHiddenTargetRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-hidden-target-rule",
+		description: "Checks that panels have at least one visible target.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if len(p.Targets) == 0 {
+				return r
+			}
+
+			var refIds []string
+			for _, t := range p.Targets {
+				if !t.Hide {
+					// Hidden targets may feed visible ones, such as expressions, or transformations.
+					return r
+				}
+				refIds = append(refIds, fmt.Sprintf("'%s'", t.RefId))
+			}
+			r.AddWarning(d, p, fmt.Sprintf("only has hidden targets %s, so it shows no data", strings.Join(refIds, ", ")))
+			return r
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package lint
+
+import "testing"
+
+func TestHiddenTargetRule(t *testing.T) {
+	linter := NewHiddenTargetRule()
+
+	for _, tc := range []struct {
+		name    string
+		result  Result
+		targets []Target
+	}{
+		{
+			name:   "no targets",
+			result: ResultSuccess,
+		},
+		{
+			name:    "visible target",
+			result:  ResultSuccess,
+			targets: []Target{{RefId: "A", Expr: `up`}},
+		},
+		{
+			name:   "hidden target feeding an expression",
+			result: ResultSuccess,
+			targets: []Target{
+				{RefId: "A", Expr: `up`, Hide: true},
+				{RefId: "B", Datasource: map[string]interface{}{"type": "__expr__", "uid": "__expr__"}},
+			},
+		},
+		{
+			name: "only hidden targets",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' only has hidden targets 'A', 'B', so it shows no data",
+			},
+			targets: []Target{
+				{RefId: "A", Expr: `up`, Hide: true},
+				{RefId: "B", Expr: `sum(up)`, Hide: true},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:    "timeseries",
+				Title:   "bar",
+				Targets: tc.targets,
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
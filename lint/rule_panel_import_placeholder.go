@@ -0,0 +1,53 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var importPlaceholderRegexp = regexp.MustCompile(`^\$\{(DS_[A-Za-z0-9_]+)\}$`)
+
+func NewImportPlaceholderRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-import-placeholder-rule",
+		description: "Checks that panels do not use ${DS_...} import placeholders without a matching input.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+
+			defined := map[string]struct{}{}
+			for _, input := range d.Inputs {
+				defined[input.Name] = struct{}{}
+			}
+			for _, template := range d.Templating.List {
+				defined[template.Name] = struct{}{}
+			}
+
+			reported := map[string]struct{}{}
+			check := func(raw interface{}) {
+				ds, err := GetDataSource(raw)
+				if err != nil {
+					// Invalid datasources are reported by panel-datasource-rule.
+					return
+				}
+				match := importPlaceholderRegexp.FindStringSubmatch(ds.UID)
+				if match == nil {
+					return
+				}
+				if _, ok := defined[match[1]]; ok {
+					return
+				}
+				if _, ok := reported[ds.UID]; ok {
+					return
+				}
+				reported[ds.UID] = struct{}{}
+				r.AddError(d, p, fmt.Sprintf("uses import placeholder '%s' as datasource, but there is no input or variable named '%s'", ds.UID, match[1]))
+			}
+
+			check(p.Datasource)
+			for _, t := range p.Targets {
+				check(t.Datasource)
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package lint
+
+import "testing"
+
+func TestImportPlaceholderRule(t *testing.T) {
+	linter := NewImportPlaceholderRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		inputs    []Input
+		templates []Template
+		panel     Panel
+	}{
+		{
+			name:   "variable",
+			result: ResultSuccess,
+			panel:  Panel{Title: "bar", Datasource: "$datasource"},
+		},
+		{
+			name:   "placeholder with input",
+			result: ResultSuccess,
+			inputs: []Input{{Name: "DS_PROMETHEUS", Type: "datasource", PluginID: "prometheus"}},
+			panel:  Panel{Title: "bar", Datasource: "${DS_PROMETHEUS}"},
+		},
+		{
+			name:      "placeholder with variable",
+			result:    ResultSuccess,
+			templates: []Template{{Name: "DS_PROMETHEUS", Type: "datasource", Query: "prometheus"}},
+			panel:     Panel{Title: "bar", Datasource: map[string]interface{}{"uid": "${DS_PROMETHEUS}", "type": "prometheus"}},
+		},
+		{
+			name: "panel placeholder",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' uses import placeholder '${DS_PROMETHEUS}' as datasource, but there is no input or variable named 'DS_PROMETHEUS'",
+			},
+			panel: Panel{Title: "bar", Datasource: map[string]interface{}{"uid": "${DS_PROMETHEUS}", "type": "prometheus"}},
+		},
+		{
+			name: "target placeholder",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' uses import placeholder '${DS_LOKI}' as datasource, but there is no input or variable named 'DS_LOKI'",
+			},
+			inputs: []Input{{Name: "DS_PROMETHEUS", Type: "datasource", PluginID: "prometheus"}},
+			panel: Panel{
+				Title:      "bar",
+				Datasource: "${DS_PROMETHEUS}",
+				Targets: []Target{
+					{Datasource: "${DS_LOKI}"},
+					{Datasource: map[string]interface{}{"uid": "${DS_LOKI}"}},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title:  "test",
+				Inputs: tc.inputs,
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: tc.templates,
+				},
+				Panels: []Panel{tc.panel},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package lint
+
+import "encoding/json"
+
+func NewLogsPanelRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-logs-rule",
+		description: "Checks that logs panels configure how labels and duplicates are displayed.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeLogs {
+				return r
+			}
+
+			var opts LogsOptions
+			if len(p.Options) > 0 {
+				if err := json.Unmarshal(p.Options, &opts); err != nil {
+					// Invalid options are reported by panel-options-rule.
+					return r
+				}
+			}
+
+			if opts.ShowLabels == nil && opts.ShowCommonLabels == nil && opts.DedupStrategy == "" {
+				r.AddWarning(d, p, "does not configure label or deduplication display options")
+			}
+			return r
+		},
+	}
+}
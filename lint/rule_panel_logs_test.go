@@ -0,0 +1,60 @@
+package lint
+
+import "testing"
+
+func TestLogsPanelRule(t *testing.T) {
+	linter := NewLogsPanelRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		options   string
+	}{
+		{
+			name:      "not a logs panel",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+		},
+		{
+			name:      "labels",
+			result:    ResultSuccess,
+			panelType: "logs",
+			options:   `{"showLabels": false, "showTime": true}`,
+		},
+		{
+			name:      "dedup",
+			result:    ResultSuccess,
+			panelType: "logs",
+			options:   `{"dedupStrategy": "exact"}`,
+		},
+		{
+			name: "no options",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' does not configure label or deduplication display options",
+			},
+			panelType: "logs",
+		},
+		{
+			name: "unrelated options",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' does not configure label or deduplication display options",
+			},
+			panelType: "logs",
+			options:   `{"showTime": true, "wrapLogMessage": true}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:  tc.panelType,
+				Title: "bar",
+			}
+			if tc.options != "" {
+				panel.Options = []byte(tc.options)
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
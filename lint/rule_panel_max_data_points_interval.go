@@ -0,0 +1,19 @@
+package lint
+
+import "fmt"
+
+func NewMaxDataPointsIntervalRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-max-data-points-interval-rule",
+		description: "Checks that panels do not set both a literal interval and maxDataPoints.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Interval == "" || p.MaxDataPoints == nil || hasVariableReference(p.Interval) {
+				return r
+			}
+
+			r.AddWarning(d, p, fmt.Sprintf("sets both interval '%s' and maxDataPoints %d, which both control the query resolution, set only one of them", p.Interval, *p.MaxDataPoints))
+			return r
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxDataPointsIntervalRule(t *testing.T) {
+	linter := NewMaxDataPointsIntervalRule()
+	maxDataPoints := 500
+
+	for _, tc := range []struct {
+		name          string
+		result        Result
+		interval      string
+		maxDataPoints *int
+	}{
+		{
+			name:   "neither",
+			result: ResultSuccess,
+		},
+		{
+			name:     "interval",
+			result:   ResultSuccess,
+			interval: "1m",
+		},
+		{
+			name:          "max data points",
+			result:        ResultSuccess,
+			maxDataPoints: &maxDataPoints,
+		},
+		{
+			name:          "variable interval",
+			result:        ResultSuccess,
+			interval:      "$interval",
+			maxDataPoints: &maxDataPoints,
+		},
+		{
+			name: "both",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' sets both interval '1m' and maxDataPoints 500, which both control the query resolution, set only one of them",
+			},
+			interval:      "1m",
+			maxDataPoints: &maxDataPoints,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title:  "test",
+				Panels: []Panel{{Type: "timeseries", Title: "bar", Interval: tc.interval, MaxDataPoints: tc.maxDataPoints}},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
+
+func TestPanelIntervalUnmarshal(t *testing.T) {
+	d, err := NewDashboard([]byte(`{"title": "test", "panels": [{"type": "timeseries", "interval": "1m", "maxDataPoints": 500}]}`))
+	require.NoError(t, err)
+	require.Equal(t, "1m", d.Panels[0].Interval)
+	require.NotNil(t, d.Panels[0].MaxDataPoints)
+	require.Equal(t, 500, *d.Panels[0].MaxDataPoints)
+}
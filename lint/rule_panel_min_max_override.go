@@ -0,0 +1,45 @@
+package lint
+
+import "fmt"
+
+func NewMinMaxOverrideRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-min-max-override-rule",
+		description: "Checks that min and max overrides do not contradict the panel defaults.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.FieldConfig == nil {
+				return r
+			}
+
+			defaults := p.FieldConfig.Defaults
+			for _, override := range p.FieldConfig.Overrides {
+				matcher := fmt.Sprintf("%v", override.Matcher.Options)
+				// lo and hi are the effective min and max of the fields matched by the override.
+				lo, hi := defaults.Min, defaults.Max
+				for _, o := range override.OverrideProperties {
+					value, ok := o.Value.(float64)
+					if !ok {
+						continue
+					}
+					switch o.Id {
+					case "min":
+						if defaults.Min != nil && *defaults.Min >= 0 && value < 0 {
+							r.AddWarning(d, p, fmt.Sprintf("has an override for '%s' setting min to %g, although the default min of %g is non-negative", matcher, value, *defaults.Min))
+						}
+						lo = &value
+					case "max":
+						if defaults.Max != nil && *defaults.Max <= 0 && value > 0 {
+							r.AddWarning(d, p, fmt.Sprintf("has an override for '%s' setting max to %g, although the default max of %g is non-positive", matcher, value, *defaults.Max))
+						}
+						hi = &value
+					}
+				}
+				if lo != nil && hi != nil && *lo >= *hi && (lo != defaults.Min || hi != defaults.Max) {
+					r.AddWarning(d, p, fmt.Sprintf("has an override for '%s' resulting in min %g not being below max %g", matcher, *lo, *hi))
+				}
+			}
+			return r
+		},
+	}
+}
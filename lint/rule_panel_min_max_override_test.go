@@ -0,0 +1,99 @@
+package lint
+
+import "testing"
+
+func TestMinMaxOverrideRule(t *testing.T) {
+	linter := NewMinMaxOverrideRule()
+
+	float := func(f float64) *float64 { return &f }
+	override := func(name string, properties ...OverrideProperty) Override {
+		return Override{
+			Matcher:            OverrideMatcher{Id: "byName", Options: name},
+			OverrideProperties: properties,
+		}
+	}
+
+	for _, tc := range []struct {
+		name        string
+		results     []Result
+		fieldConfig *FieldConfig
+	}{
+		{
+			name:    "no field config",
+			results: []Result{ResultSuccess},
+		},
+		{
+			name:    "consistent",
+			results: []Result{ResultSuccess},
+			fieldConfig: &FieldConfig{
+				Defaults: Defaults{Min: float(0), Max: float(100)},
+				Overrides: []Override{
+					override("errors", OverrideProperty{Id: "min", Value: 10.0}, OverrideProperty{Id: "max", Value: 50.0}),
+					override("latency", OverrideProperty{Id: "unit", Value: "s"}),
+				},
+			},
+		},
+		{
+			name:    "no default",
+			results: []Result{ResultSuccess},
+			fieldConfig: &FieldConfig{
+				Overrides: []Override{
+					override("delta", OverrideProperty{Id: "min", Value: -100.0}),
+				},
+			},
+		},
+		{
+			name: "negative min",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar' has an override for 'delta' setting min to -100, although the default min of 0 is non-negative",
+				},
+			},
+			fieldConfig: &FieldConfig{
+				Defaults: Defaults{Min: float(0)},
+				Overrides: []Override{
+					override("delta", OverrideProperty{Id: "min", Value: -100.0}),
+				},
+			},
+		},
+		{
+			name: "positive max",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar' has an override for 'delta' setting max to 10, although the default max of 0 is non-positive",
+				},
+			},
+			fieldConfig: &FieldConfig{
+				Defaults: Defaults{Min: float(-100), Max: float(0)},
+				Overrides: []Override{
+					override("delta", OverrideProperty{Id: "max", Value: 10.0}),
+				},
+			},
+		},
+		{
+			name: "min above max",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar' has an override for 'errors' resulting in min 200 not being below max 100",
+				},
+			},
+			fieldConfig: &FieldConfig{
+				Defaults: Defaults{Min: float(0), Max: float(100)},
+				Overrides: []Override{
+					override("errors", OverrideProperty{Id: "min", Value: 200.0}),
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title:  "test",
+				Panels: []Panel{{Type: "timeseries", Title: "bar", FieldConfig: tc.fieldConfig}},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
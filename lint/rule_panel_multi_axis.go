@@ -0,0 +1,54 @@
+package lint
+
+import "fmt"
+
+// minMultiAxisSeries is the number of series from which a timeseries panel is expected to need a second axis.
+const minMultiAxisSeries = 4
+
+func NewMultiAxisRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-multi-axis-rule",
+		description: "Checks that timeseries panels with many series assign some series to a second axis.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeTimeSeries {
+				return r
+			}
+
+			if estimateSeriesCount(d, p) < minMultiAxisSeries {
+				return r
+			}
+
+			if p.FieldConfig != nil {
+				placement := axisPlacement(p.FieldConfig.Defaults.Custom)
+				for _, override := range p.FieldConfig.Overrides {
+					for _, o := range override.OverrideProperties {
+						value, ok := o.Value.(string)
+						if o.Id == "custom.axisPlacement" && ok && value != "hidden" && normalizeAxisPlacement(value) != placement {
+							return r
+						}
+					}
+				}
+			}
+
+			r.AddWarning(d, p, fmt.Sprintf("may show %d or more series on a single axis, consider using overrides to move series with a different scale to a second axis", minMultiAxisSeries))
+			return r
+		},
+	}
+}
+
+func axisPlacement(custom *FieldCustom) string {
+	if custom == nil {
+		return normalizeAxisPlacement("")
+	}
+	return normalizeAxisPlacement(custom.AxisPlacement)
+}
+
+// normalizeAxisPlacement treats "auto", and an unset placement, as "left", which is where Grafana places
+// the first axis.
+func normalizeAxisPlacement(placement string) string {
+	if placement == "" || placement == "auto" {
+		return "left"
+	}
+	return placement
+}
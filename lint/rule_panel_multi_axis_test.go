@@ -0,0 +1,101 @@
+package lint
+
+import "testing"
+
+func TestMultiAxisRule(t *testing.T) {
+	linter := NewMultiAxisRule()
+
+	targets := func(n int) []Target {
+		var out []Target
+		for i := 0; i < n; i++ {
+			out = append(out, Target{Expr: "sum(up)"})
+		}
+		return out
+	}
+	rightAxis := []Override{{
+		Matcher:            OverrideMatcher{Id: "byName", Options: "errors"},
+		OverrideProperties: []OverrideProperty{{Id: "custom.axisPlacement", Value: "right"}},
+	}}
+
+	for _, tc := range []struct {
+		name        string
+		result      Result
+		panelType   string
+		targets     []Target
+		fieldConfig *FieldConfig
+	}{
+		{
+			name:      "few series",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			targets:   targets(3),
+		},
+		{
+			name:      "hidden series",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			targets:   append(targets(3), Target{Expr: "sum(up)", Hide: true}),
+		},
+		{
+			name: "unaggregated target",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' may show 4 or more series on a single axis, consider using overrides to move series with a different scale to a second axis",
+			},
+			panelType: "timeseries",
+			targets:   []Target{{Expr: "sum by (job) (up)"}},
+		},
+		{
+			name:        "unaggregated target with second axis",
+			result:      ResultSuccess,
+			panelType:   "timeseries",
+			targets:     []Target{{Expr: "sum by (job) (up)"}},
+			fieldConfig: &FieldConfig{Overrides: rightAxis},
+		},
+		{
+			name:      "not a timeseries",
+			result:    ResultSuccess,
+			panelType: "table",
+			targets:   targets(4),
+		},
+		{
+			name:        "second axis",
+			result:      ResultSuccess,
+			panelType:   "timeseries",
+			targets:     targets(4),
+			fieldConfig: &FieldConfig{Overrides: rightAxis},
+		},
+		{
+			name: "single axis",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' may show 4 or more series on a single axis, consider using overrides to move series with a different scale to a second axis",
+			},
+			panelType: "timeseries",
+			targets:   targets(4),
+		},
+		{
+			name: "override matches defaults",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' may show 4 or more series on a single axis, consider using overrides to move series with a different scale to a second axis",
+			},
+			panelType: "timeseries",
+			targets:   targets(5),
+			fieldConfig: &FieldConfig{
+				Defaults:  Defaults{Custom: &FieldCustom{AxisPlacement: "right"}},
+				Overrides: rightAxis,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:        tc.panelType,
+				Title:       "bar",
+				Targets:     tc.targets,
+				FieldConfig: tc.fieldConfig,
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// panelOptions returns a new value of the options type of the given panel type, or nil if the options of
+// the panel type aren't parsed.
+func panelOptions(panelType string) interface{} {
+	switch panelType {
+	case panelTypeStat, panelTypeGauge, panelTypeBarGauge:
+		return &StatOptions{}
+	case panelTypeTimeTable:
+		return &TableOptions{}
+	case panelTypeTimeSeries:
+		return &TimeseriesOptions{}
+	case panelTypeLogs:
+		return &LogsOptions{}
+	case panelTypeHeatmap:
+		return &HeatmapOptions{}
+	}
+	return nil
+}
+
+// NewPanelOptionsRule reports panel options which can't be parsed. Other rules checking panel options skip
+// these panels, so that each panel is only reported once.
+func NewPanelOptionsRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-options-rule",
+		description: "Checks that panel options are valid for the panel type.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			opts := panelOptions(p.Type)
+			if opts == nil || len(p.Options) == 0 {
+				return r
+			}
+
+			if err := json.Unmarshal(p.Options, opts); err != nil {
+				r.AddError(d, p, fmt.Sprintf("has invalid options: %v", err))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package lint
+
+import "testing"
+
+func TestPanelOptionsRule(t *testing.T) {
+	linter := NewPanelOptionsRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		options   string
+	}{
+		{
+			name:      "no options",
+			result:    ResultSuccess,
+			panelType: "stat",
+		},
+		{
+			name:      "valid options",
+			result:    ResultSuccess,
+			panelType: "stat",
+			options:   `{"textMode": "auto", "reduceOptions": {"limit": 10}}`,
+		},
+		{
+			name:      "unknown panel type",
+			result:    ResultSuccess,
+			panelType: "text",
+			options:   `{"mode": 1}`,
+		},
+		{
+			name: "invalid stat options",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' has invalid options: json: cannot unmarshal number into Go struct field StatOptions.textMode of type string",
+			},
+			panelType: "stat",
+			options:   `{"textMode": 1}`,
+		},
+		{
+			name: "invalid gauge options",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' has invalid options: json: cannot unmarshal string into Go struct field StatOptions.reduceOptions.limit of type int",
+			},
+			panelType: "gauge",
+			options:   `{"reduceOptions": {"limit": "10"}}`,
+		},
+		{
+			name: "invalid table options",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' has invalid options: json: cannot unmarshal array into Go value of type lint.TableOptions",
+			},
+			panelType: "table",
+			options:   `[]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:  tc.panelType,
+				Title: "bar",
+			}
+			if tc.options != "" {
+				panel.Options = []byte(tc.options)
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package lint
+
+import "encoding/json"
+
+// NewOrientationRule is not part of the default rule set, as automatic orientation is the default, and works
+// well for panels which are not resized much and whose series names are short.
+func NewOrientationRule() *PanelRuleFunc {
+	return NewOrientationRuleWithPanelTypes(panelTypeBarGauge, panelTypeGauge)
+}
+
+// NewOrientationRuleWithPanelTypes is like NewOrientationRule, but allows the panel types which should
+// set a fixed orientation to be configured.
+func NewOrientationRuleWithPanelTypes(panelTypes ...string) *PanelRuleFunc {
+	types := make(map[string]struct{}, len(panelTypes))
+	for _, panelType := range panelTypes {
+		types[panelType] = struct{}{}
+	}
+
+	return &PanelRuleFunc{
+		name:        "panel-orientation-rule",
+		description: "Checks that gauge and bar gauge panels set a fixed orientation.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if _, ok := types[p.Type]; !ok {
+				return r
+			}
+
+			var opts StatOptions
+			if len(p.Options) > 0 {
+				if err := json.Unmarshal(p.Options, &opts); err != nil {
+					// Invalid options are reported by panel-options-rule.
+					return r
+				}
+			}
+			if opts.Orientation == "" || opts.Orientation == "auto" {
+				r.AddWarning(d, p, "uses automatic orientation, which changes with the panel size, set orientation to 'horizontal' or 'vertical'")
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOrientationRule(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		linter    *PanelRuleFunc
+		result    Result
+		panelType string
+		options   json.RawMessage
+	}{
+		{
+			name:      "horizontal",
+			linter:    NewOrientationRule(),
+			result:    ResultSuccess,
+			panelType: "bargauge",
+			options:   json.RawMessage(`{"orientation": "horizontal"}`),
+		},
+		{
+			name:      "not configured",
+			linter:    NewOrientationRule(),
+			result:    ResultSuccess,
+			panelType: "stat",
+			options:   json.RawMessage(`{"orientation": "auto"}`),
+		},
+		{
+			name:   "auto",
+			linter: NewOrientationRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' uses automatic orientation, which changes with the panel size, set orientation to 'horizontal' or 'vertical'",
+			},
+			panelType: "bargauge",
+			options:   json.RawMessage(`{"orientation": "auto"}`),
+		},
+		{
+			name:   "unset",
+			linter: NewOrientationRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' uses automatic orientation, which changes with the panel size, set orientation to 'horizontal' or 'vertical'",
+			},
+			panelType: "gauge",
+		},
+		{
+			name:   "configured panel types",
+			linter: NewOrientationRuleWithPanelTypes("stat"),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' uses automatic orientation, which changes with the panel size, set orientation to 'horizontal' or 'vertical'",
+			},
+			panelType: "stat",
+			options:   json.RawMessage(`{"orientation": "auto"}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title:  "test",
+				Panels: []Panel{{Type: tc.panelType, Title: "bar", Options: tc.options}},
+			}
+			testRule(t, tc.linter, d, tc.result)
+		})
+	}
+}
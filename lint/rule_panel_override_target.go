@@ -0,0 +1,103 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// legendPartRegexp matches the parts of a legend format which are replaced when a series is named, i.e.
+// {{label}} tokens and variables.
+var legendPartRegexp = regexp.MustCompile(legendTokenRegexp.String() + "|" + variableRegexp.String())
+
+func NewOverrideTargetRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-override-target-rule",
+		description: "Checks that unit overrides target fields which the panel queries produce.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.FieldConfig == nil || len(p.FieldConfig.Overrides) == 0 {
+				return r
+			}
+			patterns, ok := fieldNamePatterns(d, p)
+			if !ok {
+				return r
+			}
+
+			for _, override := range p.FieldConfig.Overrides {
+				name, ok := override.Matcher.Options.(string)
+				if !ok || override.Matcher.Id != "byName" || hasVariableReference(name) {
+					continue
+				}
+				for _, o := range override.OverrideProperties {
+					if o.Id != "unit" {
+						continue
+					}
+					if !matchesAny(patterns, name) {
+						r.AddWarning(d, p, fmt.Sprintf("has an override setting the unit of field '%s', which none of its queries produce", name))
+					}
+					break
+				}
+			}
+			return r
+		},
+	}
+}
+
+// fieldNamePatterns returns patterns matching the names of the fields produced by the queries of p, derived
+// from their legend formats. The second return value is false when the names can't be inferred, e.g.
+// because a query has no legend format, or transformations or display names may rename the fields.
+func fieldNamePatterns(d Dashboard, p Panel) ([]*regexp.Regexp, bool) {
+	switch p.Type {
+	case panelTypeTimeSeries, panelTypeStat, panelTypeGauge, panelTypeBarGauge:
+	default:
+		return nil, false
+	}
+	if len(p.Transformations) > 0 {
+		return nil, false
+	}
+	if p.FieldConfig != nil {
+		for _, override := range p.FieldConfig.Overrides {
+			for _, o := range override.OverrideProperties {
+				if o.Id == "displayName" || o.Id == "displayNameFromDS" {
+					return nil, false
+				}
+			}
+		}
+	}
+
+	patterns := []*regexp.Regexp{regexp.MustCompile(`^Time$`)}
+	for _, t := range p.Targets {
+		if t.Hide {
+			continue
+		}
+		if targetDatasourceType(d, p, t) != Prometheus || (t.Format != "" && t.Format != "time_series") {
+			return nil, false
+		}
+		if t.LegendFormat == "" || t.LegendFormat == "__auto" {
+			return nil, false
+		}
+
+		var pattern strings.Builder
+		pattern.WriteString("^")
+		cursor := 0
+		for _, loc := range legendPartRegexp.FindAllStringIndex(t.LegendFormat, -1) {
+			pattern.WriteString(regexp.QuoteMeta(t.LegendFormat[cursor:loc[0]]))
+			pattern.WriteString(".*")
+			cursor = loc[1]
+		}
+		pattern.WriteString(regexp.QuoteMeta(t.LegendFormat[cursor:]))
+		pattern.WriteString("$")
+		patterns = append(patterns, regexp.MustCompile(pattern.String()))
+	}
+	return patterns, len(patterns) > 1
+}
+
+func matchesAny(patterns []*regexp.Regexp, name string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package lint
+
+import "testing"
+
+func TestOverrideTargetRule(t *testing.T) {
+	linter := NewOverrideTargetRule()
+
+	unit := func(name string) Override {
+		return Override{
+			Matcher:            OverrideMatcher{Id: "byName", Options: name},
+			OverrideProperties: []OverrideProperty{{Id: "unit", Value: "s"}},
+		}
+	}
+	targets := []Target{
+		{RefId: "A", Expr: `sum(rate(http_requests_total[5m]))`, LegendFormat: "Requests"},
+		{RefId: "B", Expr: `histogram_quantile(0.99, sum by (le, route) (rate(http_request_duration_seconds_bucket[5m])))`, LegendFormat: "p99 {{route}}"},
+	}
+
+	for _, tc := range []struct {
+		name            string
+		result          Result
+		panelType       string
+		targets         []Target
+		overrides       []Override
+		transformations []Transformation
+	}{
+		{
+			name:      "existing fields",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			targets:   targets,
+			overrides: []Override{unit("Requests"), unit("p99 /api"), unit("Time")},
+		},
+		{
+			name:      "no legend",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			targets:   []Target{{RefId: "A", Expr: `sum(rate(http_requests_total[5m]))`}},
+			overrides: []Override{unit("Latency")},
+		},
+		{
+			name:            "transformed",
+			result:          ResultSuccess,
+			panelType:       "timeseries",
+			targets:         targets,
+			overrides:       []Override{unit("Latency")},
+			transformations: []Transformation{{Id: "calculateField"}},
+		},
+		{
+			name:      "variable in override",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			targets:   targets,
+			overrides: []Override{unit("$job latency")},
+		},
+		{
+			name:      "not a unit override",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			targets:   targets,
+			overrides: []Override{{
+				Matcher:            OverrideMatcher{Id: "byName", Options: "Latency"},
+				OverrideProperties: []OverrideProperty{{Id: "color", Value: map[string]any{"mode": "fixed"}}},
+			}},
+		},
+		{
+			name:      "table panel",
+			result:    ResultSuccess,
+			panelType: "table",
+			targets:   targets,
+			overrides: []Override{unit("Latency")},
+		},
+		{
+			name: "missing field",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has an override setting the unit of field 'Latency', which none of its queries produce",
+			},
+			panelType: "timeseries",
+			targets:   targets,
+			overrides: []Override{unit("Requests"), unit("Latency")},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{{Type: "datasource", Query: "prometheus"}},
+				},
+				Panels: []Panel{
+					{
+						Type:            tc.panelType,
+						Title:           "bar",
+						Targets:         tc.targets,
+						FieldConfig:     &FieldConfig{Overrides: tc.overrides},
+						Transformations: tc.transformations,
+					},
+				},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package lint
+
+import "fmt"
+
+func NewPercentAxisRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-percent-axis-rule",
+		description: "Checks that panels using percent units start their axis at zero.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+
+			unit := getConfiguredUnit(p)
+			if unit != "percent" && unit != "percentunit" {
+				return r
+			}
+
+			// A percent unit implies a non-nil FieldConfig.
+			minimum := p.FieldConfig.Defaults.Min
+			if minimum == nil {
+				r.AddWarning(d, p, fmt.Sprintf("uses unit '%s' but does not set min, should be set to 0", unit))
+			} else if *minimum != 0 {
+				r.AddWarning(d, p, fmt.Sprintf("uses unit '%s' with min %g, should be set to 0", unit, *minimum))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package lint
+
+import "testing"
+
+func TestPercentAxisRule(t *testing.T) {
+	linter := NewPercentAxisRule()
+	zero, ten := 0.0, 10.0
+
+	for _, tc := range []struct {
+		name   string
+		result Result
+		panel  Panel
+	}{
+		{
+			name:   "non-percent unit",
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{Unit: "bytes"},
+				},
+			},
+		},
+		{
+			name: "min unset",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' uses unit 'percent' but does not set min, should be set to 0",
+			},
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{Unit: "percent"},
+				},
+			},
+		},
+		{
+			name: "non-zero min",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' uses unit 'percentunit' with min 10, should be set to 0",
+			},
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{Unit: "percentunit", Min: &ten},
+				},
+			},
+		},
+		{
+			name:   "zero min",
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{Unit: "percentunit", Min: &zero},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{tc.panel}}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package lint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NewPluginVersionRule is not part of the default rule set, as the Grafana version dashboards are expected
+// to be maintained with depends on the environment they are deployed to.
+func NewPluginVersionRule() *PanelRuleFunc {
+	return NewPluginVersionRuleWithMinimum("10.0.0")
+}
+
+// NewPluginVersionRuleWithMinimum is like NewPluginVersionRule, but allows the oldest acceptable plugin
+// version to be configured. It panics if minimum is not a valid version.
+func NewPluginVersionRuleWithMinimum(minimum string) *PanelRuleFunc {
+	minimumVersion, ok := parseVersion(minimum)
+	if !ok {
+		panic(fmt.Sprintf("invalid minimum plugin version '%s'", minimum))
+	}
+	return &PanelRuleFunc{
+		name:        "panel-plugin-version-rule",
+		description: "Checks that panels were last saved with a recent plugin version.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.PluginVersion == "" {
+				return r
+			}
+
+			version, ok := parseVersion(p.PluginVersion)
+			if !ok {
+				r.AddWarning(d, p, fmt.Sprintf("has pluginVersion '%s', which is not a valid version", p.PluginVersion))
+				return r
+			}
+			if compareVersions(version, minimumVersion) < 0 {
+				r.AddWarning(d, p, fmt.Sprintf("has pluginVersion '%s', which is older than '%s', open and save the dashboard in a newer Grafana to migrate it", p.PluginVersion, minimum))
+			}
+			return r
+		},
+	}
+}
+
+// compareVersions compares the major, minor and patch versions of a and b, and returns -1, 0 or 1 like
+// strings.Compare.
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseVersion returns the major, minor and patch versions of s, ignoring any pre-release or build suffix.
+// The second return value is false if s isn't a valid version.
+func parseVersion(s string) ([3]int, bool) {
+	var v [3]int
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) > len(v) {
+		return v, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return v, false
+		}
+		v[i] = n
+	}
+	return v, true
+}
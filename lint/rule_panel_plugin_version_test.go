@@ -0,0 +1,75 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginVersionRule(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		linter  *PanelRuleFunc
+		result  Result
+		version string
+	}{
+		{
+			name:   "no version",
+			linter: NewPluginVersionRule(),
+			result: ResultSuccess,
+		},
+		{
+			name:    "recent",
+			linter:  NewPluginVersionRule(),
+			result:  ResultSuccess,
+			version: "10.4.1",
+		},
+		{
+			name:    "pre-release",
+			linter:  NewPluginVersionRule(),
+			result:  ResultSuccess,
+			version: "11.0.0-pre",
+		},
+		{
+			name:   "old",
+			linter: NewPluginVersionRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has pluginVersion '9.5.2', which is older than '10.0.0', open and save the dashboard in a newer Grafana to migrate it",
+			},
+			version: "9.5.2",
+		},
+		{
+			name:   "custom minimum",
+			linter: NewPluginVersionRuleWithMinimum("11.2"),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has pluginVersion '11.1.4', which is older than '11.2', open and save the dashboard in a newer Grafana to migrate it",
+			},
+			version: "11.1.4",
+		},
+		{
+			name:   "invalid",
+			linter: NewPluginVersionRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has pluginVersion 'latest', which is not a valid version",
+			},
+			version: "latest",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title:  "test",
+				Panels: []Panel{{Type: "timeseries", Title: "bar", PluginVersion: tc.version}},
+			}
+			testRule(t, tc.linter, d, tc.result)
+		})
+	}
+}
+
+func TestPluginVersionRuleInvalidMinimum(t *testing.T) {
+	require.Panics(t, func() {
+		NewPluginVersionRuleWithMinimum("latest")
+	})
+}
@@ -0,0 +1,26 @@
+package lint
+
+import "encoding/json"
+
+func NewReduceLimitRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-reduce-limit-rule",
+		description: "Checks that stat panels showing all values set a limit.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeStat || len(p.Options) == 0 {
+				return r
+			}
+
+			var opts StatOptions
+			if err := json.Unmarshal(p.Options, &opts); err != nil {
+				// Invalid options are reported by panel-options-rule.
+				return r
+			}
+			if opts.ReduceOptions.Values && opts.ReduceOptions.Limit <= 0 {
+				r.AddWarning(d, p, "shows all values, but does not set reduceOptions.limit, so it may render a tile for every row")
+			}
+			return r
+		},
+	}
+}
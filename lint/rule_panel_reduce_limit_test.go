@@ -0,0 +1,65 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestReduceLimitRule(t *testing.T) {
+	linter := NewReduceLimitRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		options   json.RawMessage
+	}{
+		{
+			name:      "no options",
+			result:    ResultSuccess,
+			panelType: "stat",
+		},
+		{
+			name:      "calculated value",
+			result:    ResultSuccess,
+			panelType: "stat",
+			options:   json.RawMessage(`{"reduceOptions": {"values": false, "calcs": ["lastNotNull"]}}`),
+		},
+		{
+			name:      "limited",
+			result:    ResultSuccess,
+			panelType: "stat",
+			options:   json.RawMessage(`{"reduceOptions": {"values": true, "limit": 10}}`),
+		},
+		{
+			name:      "not a stat panel",
+			result:    ResultSuccess,
+			panelType: "table",
+			options:   json.RawMessage(`{"reduceOptions": {"values": true}}`),
+		},
+		{
+			name: "unlimited",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' shows all values, but does not set reduceOptions.limit, so it may render a tile for every row",
+			},
+			panelType: "stat",
+			options:   json.RawMessage(`{"reduceOptions": {"values": true, "fields": ""}}`),
+		},
+		{
+			// Reported by panel-options-rule.
+			name:      "invalid options",
+			result:    ResultSuccess,
+			panelType: "stat",
+			options:   json.RawMessage(`{"reduceOptions": {"values": true, "limit": "10"}}`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title:  "test",
+				Panels: []Panel{{Type: tc.panelType, Title: "bar", Options: tc.options}},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+func NewRedundantPanelTitleRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-redundant-title-rule",
+		description: "Checks that panels do not repeat the dashboard title.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type == panelTypeRow || p.Title == "" {
+				return r
+			}
+
+			if strings.EqualFold(strings.TrimSpace(p.Title), strings.TrimSpace(d.Title)) {
+				r.AddWarning(d, p, fmt.Sprintf("with id '%d' repeats the dashboard title, use a title describing what the panel shows", p.Id))
+			}
+			return r
+		},
+	}
+}
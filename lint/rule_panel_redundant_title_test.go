@@ -0,0 +1,38 @@
+package lint
+
+import "testing"
+
+func TestRedundantPanelTitleRule(t *testing.T) {
+	linter := NewRedundantPanelTitleRule()
+
+	for _, tc := range []struct {
+		result Result
+		panel  Panel
+	}{
+		{
+			result: ResultSuccess,
+			panel:  Panel{Id: 1, Type: "timeseries", Title: "Request rate"},
+		},
+		{
+			result: ResultSuccess,
+			panel:  Panel{Id: 1, Type: "timeseries"},
+		},
+		{
+			result: ResultSuccess,
+			panel:  Panel{Id: 1, Type: "row", Title: "API Overview"},
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'API Overview', panel 'api overview' with id '1' repeats the dashboard title, use a title describing what the panel shows",
+			},
+			panel: Panel{Id: 1, Type: "timeseries", Title: "api overview"},
+		},
+	} {
+		d := Dashboard{
+			Title:  "API Overview",
+			Panels: []Panel{tc.panel},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
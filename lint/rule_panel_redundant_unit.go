@@ -0,0 +1,30 @@
+package lint
+
+import "fmt"
+
+func NewRedundantUnitRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-redundant-unit-rule",
+		description: "Checks that panels with value mappings do not also configure a unit.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+
+			unit := getConfiguredUnit(p)
+			if unit == "" || unit == "none" {
+				return r
+			}
+
+			valueMappings, err := getValueMappings(p)
+			if err != nil {
+				r.AddError(d, p, err.Error())
+				return r
+			}
+			if mappings, ok := valueMappings.([]any); valueMappings == nil || (ok && len(mappings) == 0) {
+				return r
+			}
+
+			r.AddWarning(d, p, fmt.Sprintf("has value mappings, so its unit '%s' may never be shown", unit))
+			return r
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package lint
+
+import "testing"
+
+func TestRedundantUnitRule(t *testing.T) {
+	linter := NewRedundantUnitRule()
+	mappings := []byte(`[{"type": "value", "options": {"0": {"text": "DOWN"}, "1": {"text": "UP"}}}]`)
+
+	for _, tc := range []struct {
+		name     string
+		result   Result
+		defaults Defaults
+	}{
+		{
+			name:     "unit only",
+			result:   ResultSuccess,
+			defaults: Defaults{Unit: "short"},
+		},
+		{
+			name:     "mappings only",
+			result:   ResultSuccess,
+			defaults: Defaults{Mappings: mappings},
+		},
+		{
+			name:     "mappings with none unit",
+			result:   ResultSuccess,
+			defaults: Defaults{Unit: "none", Mappings: mappings},
+		},
+		{
+			name:     "empty mappings",
+			result:   ResultSuccess,
+			defaults: Defaults{Unit: "short", Mappings: []byte(`[]`)},
+		},
+		{
+			name: "unit and mappings",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has value mappings, so its unit 'short' may never be shown",
+			},
+			defaults: Defaults{Unit: "short", Mappings: mappings},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:        "stat",
+				Title:       "bar",
+				FieldConfig: &FieldConfig{Defaults: tc.defaults},
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package lint
+
+func NewRowTitleRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-row-title-rule",
+		description: "Checks that row panels have a title.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type == panelTypeRow && p.Title == "" {
+				r.AddWarning(d, p, "is a row without a title")
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package lint
+
+import "testing"
+
+func TestRowTitleRule(t *testing.T) {
+	linter := NewRowTitleRule()
+
+	for _, tc := range []struct {
+		name   string
+		result Result
+		panel  Panel
+	}{
+		{
+			name:   "titled row",
+			result: ResultSuccess,
+			panel:  Panel{Id: 3, Type: "row", Title: "Overview"},
+		},
+		{
+			name:   "untitled panel",
+			result: ResultSuccess,
+			panel:  Panel{Id: 3, Type: "text"},
+		},
+		{
+			name: "untitled row",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel with id '3' is a row without a title",
+			},
+			panel: Panel{Id: 3, Type: "row"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{tc.panel}}, tc.result)
+		})
+	}
+}
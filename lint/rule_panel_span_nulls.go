@@ -0,0 +1,21 @@
+package lint
+
+// NewSpanNullsRule is not part of the default rule set, as disconnected lines are correct for metrics
+// which are expected to have gaps.
+func NewSpanNullsRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-span-nulls-rule",
+		description: "Checks that timeseries panels do not explicitly disable spanNulls.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeTimeSeries || p.FieldConfig == nil || p.FieldConfig.Defaults.Custom == nil {
+				return r
+			}
+
+			if spanNulls, ok := p.FieldConfig.Defaults.Custom.SpanNulls.(bool); ok && !spanNulls {
+				r.AddWarning(d, p, "sets spanNulls to false, which draws sparse series as disconnected points, consider a threshold such as 1h instead")
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package lint
+
+import "testing"
+
+func TestSpanNullsRule(t *testing.T) {
+	linter := NewSpanNullsRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		custom    *FieldCustom
+	}{
+		{
+			name:      "unset",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			custom:    &FieldCustom{},
+		},
+		{
+			name:      "enabled",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			custom:    &FieldCustom{SpanNulls: true},
+		},
+		{
+			name:      "threshold",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			custom:    &FieldCustom{SpanNulls: float64(3600000)},
+		},
+		{
+			name:      "not a timeseries",
+			result:    ResultSuccess,
+			panelType: "stat",
+			custom:    &FieldCustom{SpanNulls: false},
+		},
+		{
+			name: "disabled",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' sets spanNulls to false, which draws sparse series as disconnected points, consider a threshold such as 1h instead",
+			},
+			panelType: "timeseries",
+			custom:    &FieldCustom{SpanNulls: false},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:        tc.panelType,
+				Title:       "bar",
+				FieldConfig: &FieldConfig{Defaults: Defaults{Custom: tc.custom}},
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// negativeFunctions are PromQL functions which return negative values for series which decrease.
+var negativeFunctions = map[string]bool{
+	"deriv":  true,
+	"delta":  true,
+	"idelta": true,
+}
+
+func NewStackingRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-stacking-rule",
+		description: "Checks that timeseries panels do not stack series which can be negative.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeTimeSeries || p.FieldConfig == nil || p.FieldConfig.Defaults.Custom == nil {
+				return r
+			}
+			stacking := p.FieldConfig.Defaults.Custom.Stacking
+			if stacking == nil || stacking.Mode == "" || stacking.Mode == "none" {
+				return r
+			}
+
+			for _, t := range p.Targets {
+				if t.Hide || targetDatasourceType(d, p, t) != Prometheus {
+					continue
+				}
+				expr, err := parsePromQL(t.Expr, d.Templating.List)
+				if err != nil {
+					// Invalid PromQL is another rule
+					continue
+				}
+				if reason := negativeReason(expr); reason != "" {
+					r.AddWarning(d, p, fmt.Sprintf("stacks series, but refId '%s' uses %s, which can be negative and misrepresent the total", t.RefId, reason))
+					return r
+				}
+			}
+			return r
+		},
+	}
+}
+
+// negativeReason returns a description of the first part of expr which can produce negative values, or
+// an empty string if there is none.
+func negativeReason(expr parser.Expr) string {
+	reason := ""
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		if reason != "" {
+			return nil
+		}
+		switch n := node.(type) {
+		case *parser.Call:
+			if negativeFunctions[n.Func.Name] {
+				reason = fmt.Sprintf("'%s'", n.Func.Name)
+			}
+		case *parser.BinaryExpr:
+			if n.Op == parser.SUB {
+				reason = "subtraction"
+			}
+		}
+		return nil
+	})
+	return reason
+}
@@ -0,0 +1,70 @@
+package lint
+
+import "testing"
+
+func TestStackingRule(t *testing.T) {
+	linter := NewStackingRule()
+
+	for _, tc := range []struct {
+		name     string
+		result   Result
+		stacking *Stacking
+		expr     string
+	}{
+		{
+			name:   "not stacked",
+			result: ResultSuccess,
+			expr:   `deriv(node_filesystem_free_bytes[1h])`,
+		},
+		{
+			name:     "stacking disabled",
+			result:   ResultSuccess,
+			stacking: &Stacking{Mode: "none"},
+			expr:     `deriv(node_filesystem_free_bytes[1h])`,
+		},
+		{
+			name:     "non-negative query",
+			result:   ResultSuccess,
+			stacking: &Stacking{Mode: "normal"},
+			expr:     `sum by (mode) (rate(node_cpu_seconds_total[5m]))`,
+		},
+		{
+			name: "deriv",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' stacks series, but refId 'A' uses 'deriv', which can be negative and misrepresent the total",
+			},
+			stacking: &Stacking{Mode: "normal"},
+			expr:     `sum by (device) (deriv(node_filesystem_free_bytes[1h]))`,
+		},
+		{
+			name: "subtraction",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' stacks series, but refId 'A' uses subtraction, which can be negative and misrepresent the total",
+			},
+			stacking: &Stacking{Mode: "percent"},
+			expr:     `node_memory_MemTotal_bytes - node_memory_MemFree_bytes`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{{Type: "datasource", Query: "prometheus"}},
+				},
+				Panels: []Panel{
+					{
+						Type:        "timeseries",
+						Title:       "bar",
+						FieldConfig: &FieldConfig{Defaults: Defaults{Custom: &FieldCustom{Stacking: tc.stacking}}},
+						Targets:     []Target{{RefId: "A", Expr: tc.expr}},
+					},
+				},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
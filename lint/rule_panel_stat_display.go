@@ -0,0 +1,31 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func NewStatDisplayRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-stat-display-rule",
+		description: "Checks that stat panels display their value.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeStat || len(p.Options) == 0 {
+				return r
+			}
+
+			var opts StatOptions
+			if err := json.Unmarshal(p.Options, &opts); err != nil {
+				// Invalid options are reported by panel-options-rule.
+				return r
+			}
+
+			switch opts.TextMode {
+			case "name", "none":
+				r.AddWarning(d, p, fmt.Sprintf("has text mode '%s', which does not show the value", opts.TextMode))
+			}
+			return r
+		},
+	}
+}
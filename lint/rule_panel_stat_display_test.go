@@ -0,0 +1,74 @@
+package lint
+
+import "testing"
+
+func TestStatDisplayRule(t *testing.T) {
+	linter := NewStatDisplayRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		options   string
+	}{
+		{
+			name:      "no options",
+			result:    ResultSuccess,
+			panelType: "stat",
+		},
+		{
+			name:      "auto",
+			result:    ResultSuccess,
+			panelType: "stat",
+			options:   `{"textMode": "auto", "orientation": "horizontal"}`,
+		},
+		{
+			name:      "value and name",
+			result:    ResultSuccess,
+			panelType: "stat",
+			options:   `{"textMode": "value_and_name"}`,
+		},
+		{
+			name:      "not a stat panel",
+			result:    ResultSuccess,
+			panelType: "gauge",
+			options:   `{"textMode": "name"}`,
+		},
+		{
+			name: "name",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has text mode 'name', which does not show the value",
+			},
+			panelType: "stat",
+			options:   `{"textMode": "name", "orientation": "auto"}`,
+		},
+		{
+			name: "none",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has text mode 'none', which does not show the value",
+			},
+			panelType: "stat",
+			options:   `{"textMode": "none"}`,
+		},
+		{
+			// Reported by panel-options-rule.
+			name:      "invalid options",
+			result:    ResultSuccess,
+			panelType: "stat",
+			options:   `{"textMode": 1}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:  tc.panelType,
+				Title: "bar",
+			}
+			if tc.options != "" {
+				panel.Options = []byte(tc.options)
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
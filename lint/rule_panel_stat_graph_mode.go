@@ -0,0 +1,42 @@
+package lint
+
+import "encoding/json"
+
+func NewStatGraphModeRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-stat-graph-mode-rule",
+		description: "Checks that stat panels with only instant queries set graphMode.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeStat {
+				return r
+			}
+
+			var opts StatOptions
+			if len(p.Options) > 0 {
+				if err := json.Unmarshal(p.Options, &opts); err != nil {
+					// Invalid options are reported by panel-options-rule.
+					return r
+				}
+			}
+			if opts.GraphMode != "" {
+				return r
+			}
+
+			instant := false
+			for _, t := range p.Targets {
+				if t.Hide {
+					continue
+				}
+				if t.Range || !t.Instant {
+					return r
+				}
+				instant = true
+			}
+			if instant {
+				r.AddWarning(d, p, "does not set graphMode, which defaults to 'area', but only has instant queries, set graphMode to 'none'")
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatGraphModeRule(t *testing.T) {
+	linter := NewStatGraphModeRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		options   json.RawMessage
+		targets   []Target
+	}{
+		{
+			name:      "range query",
+			result:    ResultSuccess,
+			panelType: "stat",
+			targets:   []Target{{Expr: `sum(up)`}},
+		},
+		{
+			name:      "graph mode set",
+			result:    ResultSuccess,
+			panelType: "stat",
+			options:   json.RawMessage(`{"graphMode": "none"}`),
+			targets:   []Target{{Expr: `sum(up)`, Instant: true}},
+		},
+		{
+			name:      "instant and range queries",
+			result:    ResultSuccess,
+			panelType: "stat",
+			targets: []Target{
+				{Expr: `sum(up)`, Instant: true},
+				{Expr: `count(up)`},
+			},
+		},
+		{
+			name:      "not a stat panel",
+			result:    ResultSuccess,
+			panelType: "gauge",
+			targets:   []Target{{Expr: `sum(up)`, Instant: true}},
+		},
+		{
+			name: "instant query",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' does not set graphMode, which defaults to 'area', but only has instant queries, set graphMode to 'none'",
+			},
+			panelType: "stat",
+			options:   json.RawMessage(`{"textMode": "value"}`),
+			targets: []Target{
+				{Expr: `sum(up)`, Instant: true},
+				{Expr: `count(up)`, Hide: true},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:    tc.panelType,
+				Title:   "bar",
+				Options: tc.options,
+				Targets: tc.targets,
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
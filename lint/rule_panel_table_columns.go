@@ -0,0 +1,38 @@
+package lint
+
+// tableColumnTransformations are the transformations which select, order or rename the columns of a table.
+var tableColumnTransformations = []string{"organize", "filterFieldsByName"}
+
+func NewTableColumnRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-table-columns-rule",
+		description: "Checks that table panels organize or rename their columns.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeTimeTable {
+				return r
+			}
+
+			for _, t := range p.Transformations {
+				for _, id := range tableColumnTransformations {
+					if t.Id == id {
+						return r
+					}
+				}
+			}
+
+			if p.FieldConfig != nil {
+				for _, override := range p.FieldConfig.Overrides {
+					for _, o := range override.OverrideProperties {
+						if o.Id == "displayName" {
+							return r
+						}
+					}
+				}
+			}
+
+			r.AddWarning(d, p, "does not organize or rename its columns, add an 'organize' transformation or 'displayName' overrides")
+			return r
+		},
+	}
+}
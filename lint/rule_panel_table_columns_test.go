@@ -0,0 +1,70 @@
+package lint
+
+import "testing"
+
+func TestTableColumnRule(t *testing.T) {
+	linter := NewTableColumnRule()
+
+	for _, tc := range []struct {
+		name   string
+		result Result
+		panel  Panel
+	}{
+		{
+			name:   "not a table",
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "bar",
+			},
+		},
+		{
+			name: "raw table",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' does not organize or rename its columns, add an 'organize' transformation or 'displayName' overrides",
+			},
+			panel: Panel{
+				Type:  "table",
+				Title: "bar",
+				Transformations: []Transformation{
+					{Id: "merge"},
+				},
+			},
+		},
+		{
+			name:   "organize transformation",
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "table",
+				Title: "bar",
+				Transformations: []Transformation{
+					{Id: "merge"},
+					{Id: "organize", Options: []byte(`{"renameByName": {"Value": "Requests"}}`)},
+				},
+			},
+		},
+		{
+			name:   "displayName override",
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "table",
+				Title: "bar",
+				FieldConfig: &FieldConfig{
+					Overrides: []Override{
+						{
+							Matcher: OverrideMatcher{Id: "byName", Options: "Value"},
+							OverrideProperties: []OverrideProperty{
+								{Id: "displayName", Value: "Requests"},
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{tc.panel}}, tc.result)
+		})
+	}
+}
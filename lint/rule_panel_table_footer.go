@@ -0,0 +1,110 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func NewTableFooterRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-table-footer-rule",
+		description: "Checks that table footers are calculated for fields the table has.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeTimeTable || len(p.Options) == 0 {
+				return r
+			}
+
+			var opts TableOptions
+			if err := json.Unmarshal(p.Options, &opts); err != nil {
+				// Invalid options are reported by panel-options-rule.
+				return r
+			}
+			if opts.Footer == nil || !opts.Footer.Show {
+				return r
+			}
+
+			fields, known := tableFieldNames(p)
+			if !known {
+				return r
+			}
+			for _, name := range opts.Footer.FieldNames() {
+				if _, ok := fields[name]; !ok {
+					r.AddWarning(d, p, fmt.Sprintf("has a footer calculated for field '%s', which is not one of its fields", name))
+				}
+			}
+			return r
+		},
+	}
+}
+
+// tableFieldNames returns a best-effort set of the field names of a table panel, taken from the fields
+// named by its organize and filterFieldsByName transformations, calculated fields and overrides. The field
+// names are only known if the panel has one of these transformations, as otherwise they depend on the
+// data returned by its queries.
+func tableFieldNames(p Panel) (map[string]struct{}, bool) {
+	fields := map[string]struct{}{}
+	excluded := map[string]bool{}
+	known := false
+	for _, t := range p.Transformations {
+		var opts struct {
+			ExcludeByName map[string]bool   `json:"excludeByName"`
+			IndexByName   map[string]int    `json:"indexByName"`
+			RenameByName  map[string]string `json:"renameByName"`
+			Include       struct {
+				Names []string `json:"names"`
+			} `json:"include"`
+			Alias string `json:"alias"`
+		}
+		if len(t.Options) > 0 {
+			if err := json.Unmarshal(t.Options, &opts); err != nil {
+				return nil, false
+			}
+		}
+		switch t.Id {
+		case "organize":
+			known = true
+			for name := range opts.IndexByName {
+				fields[name] = struct{}{}
+			}
+			for name, rename := range opts.RenameByName {
+				fields[name] = struct{}{}
+				if rename != "" {
+					fields[rename] = struct{}{}
+				}
+			}
+			for name, exclude := range opts.ExcludeByName {
+				excluded[name] = excluded[name] || exclude
+			}
+		case "filterFieldsByName":
+			known = true
+			for _, name := range opts.Include.Names {
+				fields[name] = struct{}{}
+			}
+		case "calculateField":
+			if opts.Alias != "" {
+				fields[opts.Alias] = struct{}{}
+			}
+		}
+	}
+
+	if p.FieldConfig != nil {
+		for _, override := range p.FieldConfig.Overrides {
+			if name, ok := override.Matcher.Options.(string); ok && override.Matcher.Id == "byName" {
+				fields[name] = struct{}{}
+			}
+			for _, o := range override.OverrideProperties {
+				if name, ok := o.Value.(string); ok && o.Id == "displayName" {
+					fields[name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for name, exclude := range excluded {
+		if exclude {
+			delete(fields, name)
+		}
+	}
+	return fields, known
+}
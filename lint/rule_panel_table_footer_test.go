@@ -0,0 +1,88 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTableFooterRule(t *testing.T) {
+	linter := NewTableFooterRule()
+	organize := Transformation{
+		Id:      "organize",
+		Options: json.RawMessage(`{"excludeByName": {"Time": true}, "indexByName": {"job": 0, "Value": 1}, "renameByName": {"Value": "Requests"}}`),
+	}
+
+	for _, tc := range []struct {
+		name            string
+		result          Result
+		options         string
+		transformations []Transformation
+	}{
+		{
+			name:            "no footer",
+			result:          ResultSuccess,
+			options:         `{"showHeader": true}`,
+			transformations: []Transformation{organize},
+		},
+		{
+			name:            "hidden footer",
+			result:          ResultSuccess,
+			options:         `{"footer": {"show": false, "reducer": ["sum"], "fields": ["Errors"]}}`,
+			transformations: []Transformation{organize},
+		},
+		{
+			name:            "all fields",
+			result:          ResultSuccess,
+			options:         `{"footer": {"show": true, "reducer": ["sum"], "fields": ""}}`,
+			transformations: []Transformation{organize},
+		},
+		{
+			name:            "renamed field",
+			result:          ResultSuccess,
+			options:         `{"footer": {"show": true, "reducer": ["sum"], "fields": ["Requests"]}}`,
+			transformations: []Transformation{organize},
+		},
+		{
+			name:    "unknown fields",
+			result:  ResultSuccess,
+			options: `{"footer": {"show": true, "reducer": ["sum"], "fields": ["Errors"]}}`,
+		},
+		{
+			name:    "calculated field",
+			result:  ResultSuccess,
+			options: `{"footer": {"show": true, "reducer": ["sum"], "fields": ["Total"]}}`,
+			transformations: []Transformation{
+				{Id: "calculateField", Options: json.RawMessage(`{"alias": "Total", "mode": "reduceRow"}`)},
+				organize,
+			},
+		},
+		{
+			name: "missing field",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has a footer calculated for field 'Errors', which is not one of its fields",
+			},
+			options:         `{"footer": {"show": true, "reducer": ["sum"], "fields": ["Requests", "Errors"]}}`,
+			transformations: []Transformation{organize},
+		},
+		{
+			name: "excluded field",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' has a footer calculated for field 'Time', which is not one of its fields",
+			},
+			options:         `{"footer": {"show": true, "reducer": ["max"], "fields": ["Time"]}}`,
+			transformations: []Transformation{organize},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:            "table",
+				Title:           "bar",
+				Options:         json.RawMessage(tc.options),
+				Transformations: tc.transformations,
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package lint
+
+import "fmt"
+
+func NewThresholdOrderRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-threshold-order-rule",
+		description: "Checks that threshold steps are in increasing order.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.FieldConfig == nil || p.FieldConfig.Defaults.Thresholds == nil {
+				return r
+			}
+
+			var previous *float64
+			for i, step := range p.FieldConfig.Defaults.Thresholds.Steps {
+				// The base step has no value.
+				if step.Value == nil {
+					continue
+				}
+				if previous != nil && *step.Value <= *previous {
+					r.AddError(d, p, fmt.Sprintf("has threshold step %d with value %g, which is not above the previous value %g", i, *step.Value, *previous))
+				}
+				previous = step.Value
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholdOrderRule(t *testing.T) {
+	linter := NewThresholdOrderRule()
+
+	steps := func(values ...float64) *Thresholds {
+		thresholds := &Thresholds{Mode: "absolute", Steps: []ThresholdStep{{Color: "green"}}}
+		for _, v := range values {
+			thresholds.Steps = append(thresholds.Steps, ThresholdStep{Color: "red", Value: &v})
+		}
+		return thresholds
+	}
+
+	for _, tc := range []struct {
+		name       string
+		results    []Result
+		thresholds *Thresholds
+	}{
+		{
+			name:    "no thresholds",
+			results: []Result{ResultSuccess},
+		},
+		{
+			name:       "increasing",
+			results:    []Result{ResultSuccess},
+			thresholds: steps(50, 80, 95),
+		},
+		{
+			name: "out of order",
+			results: []Result{
+				{
+					Severity: Error,
+					Message:  "Dashboard 'test', panel 'bar' has threshold step 3 with value 50, which is not above the previous value 80",
+				},
+			},
+			thresholds: steps(0, 80, 50),
+		},
+		{
+			name: "duplicate",
+			results: []Result{
+				{
+					Severity: Error,
+					Message:  "Dashboard 'test', panel 'bar' has threshold step 2 with value 80, which is not above the previous value 80",
+				},
+			},
+			thresholds: steps(80, 80, 90),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Panels: []Panel{{
+					Type:        "stat",
+					Title:       "bar",
+					FieldConfig: &FieldConfig{Defaults: Defaults{Thresholds: tc.thresholds}},
+				}},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
+
+func TestThresholdsUnmarshal(t *testing.T) {
+	var defaults Defaults
+	require.NoError(t, json.Unmarshal([]byte(`{"thresholds": {"mode": "absolute", "steps": [{"color": "green", "value": null}, {"color": "red", "value": 80}]}}`), &defaults))
+	require.NotNil(t, defaults.Thresholds)
+	require.Len(t, defaults.Thresholds.Steps, 2)
+	require.Nil(t, defaults.Thresholds.Steps[0].Value)
+	require.Equal(t, 80.0, *defaults.Thresholds.Steps[1].Value)
+}
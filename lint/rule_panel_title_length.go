@@ -0,0 +1,31 @@
+package lint
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+func NewPanelTitleLengthRule() *PanelRuleFunc {
+	return NewPanelTitleLengthRuleWithThreshold(40)
+}
+
+// NewPanelTitleLengthRuleWithThreshold is like NewPanelTitleLengthRule, but allows the maximum title length
+// to be configured.
+func NewPanelTitleLengthRuleWithThreshold(maxLength int) *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-title-length-rule",
+		description: "Checks that panel titles are short enough not to be truncated.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			// Row titles span the whole width of the dashboard.
+			if p.Type == panelTypeRow {
+				return r
+			}
+
+			if length := utf8.RuneCountInString(p.Title); length > maxLength {
+				r.AddWarning(d, p, fmt.Sprintf("title is %d characters long, which exceeds %d, and may be truncated, consider moving details to the description", length, maxLength))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package lint
+
+import "testing"
+
+func TestPanelTitleLengthRule(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		linter    *PanelRuleFunc
+		result    Result
+		panelType string
+		title     string
+	}{
+		{
+			name:      "short",
+			linter:    NewPanelTitleLengthRule(),
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			title:     "Request rate",
+		},
+		{
+			name:      "multi-byte characters",
+			linter:    NewPanelTitleLengthRule(),
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			title:     "Latenz der Anfragen über alle Dienste ø",
+		},
+		{
+			name:      "row",
+			linter:    NewPanelTitleLengthRule(),
+			result:    ResultSuccess,
+			panelType: "row",
+			title:     "Request rate and latency of all services by route",
+		},
+		{
+			name:   "long",
+			linter: NewPanelTitleLengthRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'Request rate and latency of all services by route' title is 49 characters long, which exceeds 40, and may be truncated, consider moving details to the description",
+			},
+			panelType: "timeseries",
+			title:     "Request rate and latency of all services by route",
+		},
+		{
+			name:   "configured threshold",
+			linter: NewPanelTitleLengthRuleWithThreshold(10),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'Request rate' title is 12 characters long, which exceeds 10, and may be truncated, consider moving details to the description",
+			},
+			panelType: "timeseries",
+			title:     "Request rate",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title:  "test",
+				Panels: []Panel{{Type: tc.panelType, Title: tc.title}},
+			}
+			testRule(t, tc.linter, d, tc.result)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package lint
+
+import "fmt"
+
+func NewPanelTitleVariableRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-title-variable-rule",
+		description: "Checks that variables referenced in panel titles exist.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			for _, name := range unknownVariables(p.Title, d.Templating.List) {
+				r.AddError(d, p, fmt.Sprintf("title references unknown variable '%s'", name))
+			}
+			return r
+		},
+	}
+}
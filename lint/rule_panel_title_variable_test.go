@@ -0,0 +1,77 @@
+package lint
+
+import "testing"
+
+func TestPanelTitleVariableRule(t *testing.T) {
+	linter := NewPanelTitleVariableRule()
+
+	for _, tc := range []struct {
+		result Result
+		panel  Panel
+	}{
+		{
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "CPU",
+			},
+		},
+		{
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "CPU for $cluster",
+			},
+		},
+		{
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "CPU for ${cluster:csv} over $__range",
+			},
+		},
+		{
+			result: ResultSuccess,
+			panel: Panel{
+				Type:  "stat",
+				Title: "Cost over $5",
+			},
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'CPU for $clustre' title references unknown variable 'clustre'",
+			},
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "CPU for $clustre",
+			},
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'CPU for [[node]]' title references unknown variable 'node'",
+			},
+			panel: Panel{
+				Type:  "timeseries",
+				Title: "CPU for [[node]]",
+			},
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{
+					{
+						Name: "cluster",
+						Type: "query",
+					},
+				},
+			},
+			Panels: []Panel{tc.panel},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
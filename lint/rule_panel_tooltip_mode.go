@@ -0,0 +1,36 @@
+package lint
+
+import "encoding/json"
+
+func NewTooltipModeRule() *PanelRuleFunc {
+	return NewTooltipModeRuleWithThreshold(2)
+}
+
+// NewTooltipModeRuleWithThreshold is like NewTooltipModeRule, but allows the number of series from which
+// a shared tooltip is expected to be configured.
+func NewTooltipModeRuleWithThreshold(minSeries int) *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-tooltip-mode-rule",
+		description: "Checks that timeseries panels with several series show all of them in the tooltip.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.Type != panelTypeTimeSeries || len(p.Options) == 0 {
+				return r
+			}
+
+			var opts TimeseriesOptions
+			if err := json.Unmarshal(p.Options, &opts); err != nil {
+				// Invalid options are reported by panel-options-rule.
+				return r
+			}
+			if opts.Tooltip.Mode != "single" {
+				return r
+			}
+
+			if estimateSeriesCount(d, p) >= minSeries {
+				r.AddWarning(d, p, "may show several series, but its tooltip mode is 'single', consider 'all' to compare them on hover")
+			}
+			return r
+		},
+	}
+}
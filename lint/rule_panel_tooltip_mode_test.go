@@ -0,0 +1,94 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTooltipModeRule(t *testing.T) {
+	linter := NewTooltipModeRule()
+	single := json.RawMessage(`{"tooltip": {"mode": "single"}}`)
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		options   json.RawMessage
+		targets   []Target
+	}{
+		{
+			name:      "no options",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			targets:   []Target{{Expr: `up`}},
+		},
+		{
+			name:      "multi tooltip",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			options:   json.RawMessage(`{"tooltip": {"mode": "multi"}}`),
+			targets:   []Target{{Expr: `up`}},
+		},
+		{
+			name:      "single series",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			options:   single,
+			targets:   []Target{{Expr: `sum(up)`}},
+		},
+		{
+			name:      "not a timeseries",
+			result:    ResultSuccess,
+			panelType: "stat",
+			options:   single,
+			targets:   []Target{{Expr: `up`}},
+		},
+		{
+			name: "several series",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' may show several series, but its tooltip mode is 'single', consider 'all' to compare them on hover",
+			},
+			panelType: "timeseries",
+			options:   single,
+			targets:   []Target{{Expr: `sum by (job) (up)`}},
+		},
+		{
+			name: "several targets",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar' may show several series, but its tooltip mode is 'single', consider 'all' to compare them on hover",
+			},
+			panelType: "timeseries",
+			options:   single,
+			targets: []Target{
+				{Expr: `sum(up)`},
+				{Expr: `count(up)`},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:    tc.panelType,
+				Title:   "bar",
+				Options: tc.options,
+				Targets: tc.targets,
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
+
+func TestTooltipModeRuleWithThreshold(t *testing.T) {
+	linter := NewTooltipModeRuleWithThreshold(3)
+	panel := Panel{
+		Type:    "timeseries",
+		Title:   "bar",
+		Options: json.RawMessage(`{"tooltip": {"mode": "single"}}`),
+		Targets: []Target{
+			{Expr: `sum(up)`},
+			{Expr: `count(up)`},
+		},
+	}
+	testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, ResultSuccess)
+}
@@ -3,9 +3,20 @@ package lint
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
+// customUnitPrefixes are the prefixes Grafana accepts for custom units, e.g. "suffix:req" or "currency:€".
+// https://grafana.com/docs/grafana/latest/panels-visualizations/configure-standard-options/#custom-units
+var customUnitPrefixes = []string{"suffix:", "prefix:", "time:", "si:", "count:", "currency:"}
+
 func NewPanelUnitsRule() *PanelRuleFunc {
+	return NewPanelUnitsRuleWithUnits(nil)
+}
+
+// NewPanelUnitsRuleWithUnits is like NewPanelUnitsRule, but also accepts the given extra units. This is useful
+// for units added by newer Grafana releases or plugins which are not yet known to the linter.
+func NewPanelUnitsRuleWithUnits(extra []string) *PanelRuleFunc {
 	validUnits := []string{
 		// Enumerated from: https://github.com/grafana/grafana/blob/main/packages/grafana-data/src/valueFormats/categories.ts
 		// Scalar, e.g. number of loaded classes
@@ -65,6 +76,7 @@ func NewPanelUnitsRule() *PanelRuleFunc {
 		// Boolean
 		"bool", "bool_yes_no", "bool_on_off",
 	}
+	validUnits = append(validUnits, extra...)
 
 	return &PanelRuleFunc{
 		name:        "panel-units-rule",
@@ -99,6 +111,11 @@ func NewPanelUnitsRule() *PanelRuleFunc {
 							return r
 						}
 					}
+					for _, prefix := range customUnitPrefixes {
+						if strings.HasPrefix(configuredUnit, prefix) {
+							return r
+						}
+					}
 				}
 				r.AddError(d, p, fmt.Sprintf("has no or invalid units defined: '%s'", configuredUnit))
 			}
@@ -114,8 +131,8 @@ func getConfiguredUnit(p Panel) string {
 		for _, override := range p.FieldConfig.Overrides {
 			if len(override.OverrideProperties) > 0 {
 				for _, o := range override.OverrideProperties {
-					if o.Id == "unit" {
-						configuredUnit = o.Value.(string)
+					if s, ok := o.Value.(string); ok && o.Id == "unit" {
+						configuredUnit = s
 					}
 				}
 			}
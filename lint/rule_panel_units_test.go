@@ -92,6 +92,20 @@ func TestPanelUnits(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "custom suffix unit",
+			result: ResultSuccess,
+			panel: Panel{
+				Type:       "singlestat",
+				Datasource: "foo",
+				Title:      "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{
+						Unit: "suffix:req",
+					},
+				},
+			},
+		},
 		{
 			name:   "none - scalar",
 			result: ResultSuccess,
@@ -193,3 +207,46 @@ func TestPanelUnits(t *testing.T) {
 		})
 	}
 }
+
+func TestPanelUnitsWithUnits(t *testing.T) {
+	linter := NewPanelUnitsRuleWithUnits([]string{"myPluginUnit"})
+
+	for _, tc := range []struct {
+		name   string
+		result Result
+		unit   string
+	}{
+		{
+			name:   "extra unit",
+			result: ResultSuccess,
+			unit:   "myPluginUnit",
+		},
+		{
+			name:   "built-in unit",
+			result: ResultSuccess,
+			unit:   "bytes",
+		},
+		{
+			name: "unknown unit",
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar' has no or invalid units defined: 'otherPluginUnit'",
+			},
+			unit: "otherPluginUnit",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			panel := Panel{
+				Type:       "timeseries",
+				Datasource: "foo",
+				Title:      "bar",
+				FieldConfig: &FieldConfig{
+					Defaults: Defaults{
+						Unit: tc.unit,
+					},
+				},
+			}
+			testRule(t, linter, Dashboard{Title: "test", Panels: []Panel{panel}}, tc.result)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package lint
+
+import "fmt"
+
+func NewUnusedOverrideRule() *PanelRuleFunc {
+	return &PanelRuleFunc{
+		name:        "panel-unused-override-rule",
+		description: "Checks that field overrides match a field the panel produces.",
+		fn: func(d Dashboard, p Panel) PanelRuleResults {
+			r := PanelRuleResults{}
+			if p.FieldConfig == nil || len(p.FieldConfig.Overrides) == 0 {
+				return r
+			}
+
+			refIds := map[string]struct{}{}
+			for _, t := range p.Targets {
+				refIds[t.RefId] = struct{}{}
+			}
+			patterns, patternsOk := fieldNamePatterns(d, p)
+
+			for i, override := range p.FieldConfig.Overrides {
+				option, ok := override.Matcher.Options.(string)
+				if !ok || hasVariableReference(option) {
+					continue
+				}
+				switch override.Matcher.Id {
+				case "byFrameRefID":
+					if _, ok := refIds[option]; !ok && len(p.Targets) > 0 {
+						r.AddWarning(d, p, fmt.Sprintf("has an override idx '%d' matching refId '%s', which none of its queries have", i, option))
+					}
+				case "byName":
+					// Unit overrides are checked by panel-override-target-rule.
+					if !patternsOk || setsUnit(override) {
+						continue
+					}
+					if option == "" || !matchesAny(patterns, option) {
+						r.AddWarning(d, p, fmt.Sprintf("has an override idx '%d' matching field '%s', which none of its queries produce", i, option))
+					}
+				}
+			}
+			return r
+		},
+	}
+}
+
+func setsUnit(override Override) bool {
+	for _, o := range override.OverrideProperties {
+		if o.Id == "unit" {
+			return true
+		}
+	}
+	return false
+}
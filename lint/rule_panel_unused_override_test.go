@@ -0,0 +1,92 @@
+package lint
+
+import "testing"
+
+func TestUnusedOverrideRule(t *testing.T) {
+	linter := NewUnusedOverrideRule()
+
+	override := func(id, option, property string) Override {
+		return Override{
+			Matcher:            OverrideMatcher{Id: id, Options: option},
+			OverrideProperties: []OverrideProperty{{Id: property, Value: "right"}},
+		}
+	}
+	targets := []Target{
+		{RefId: "A", Expr: `sum(rate(http_requests_total[5m]))`, LegendFormat: "Requests"},
+		{RefId: "B", Expr: `sum by (route) (rate(http_request_errors_total[5m]))`, LegendFormat: "Errors {{route}}"},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		results   []Result
+		targets   []Target
+		overrides []Override
+	}{
+		{
+			name:    "used overrides",
+			results: []Result{ResultSuccess},
+			targets: targets,
+			overrides: []Override{
+				override("byFrameRefID", "B", "custom.axisPlacement"),
+				override("byName", "Requests", "custom.axisPlacement"),
+				override("byName", "Errors /api", "color"),
+				override("byRegexp", "Latency.*", "custom.axisPlacement"),
+			},
+		},
+		{
+			name:    "unknown field names",
+			results: []Result{ResultSuccess},
+			targets: []Target{{RefId: "A", Expr: `sum(rate(http_requests_total[5m]))`}},
+			overrides: []Override{
+				override("byName", "Latency", "custom.axisPlacement"),
+			},
+		},
+		{
+			name:    "unit override",
+			results: []Result{ResultSuccess},
+			targets: targets,
+			overrides: []Override{
+				override("byName", "Latency", "unit"),
+			},
+		},
+		{
+			name: "unused overrides",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar' has an override idx '0' matching refId 'C', which none of its queries have",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar' has an override idx '2' matching field 'Latency', which none of its queries produce",
+				},
+			},
+			targets: targets,
+			overrides: []Override{
+				override("byFrameRefID", "C", "custom.axisPlacement"),
+				override("byFrameRefID", "A", "custom.axisPlacement"),
+				override("byName", "Latency", "custom.axisPlacement"),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{{Type: "datasource", Query: "prometheus"}},
+				},
+				Panels: []Panel{
+					{
+						Type:        "timeseries",
+						Title:       "bar",
+						Targets:     tc.targets,
+						FieldConfig: &FieldConfig{Overrides: tc.overrides},
+					},
+				},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
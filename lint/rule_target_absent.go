@@ -0,0 +1,35 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewAbsentRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-absent-rule",
+		description: "Checks that timeseries queries do not graph absent() or absent_over_time().",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if p.Type != panelTypeTimeSeries && p.Type != panelTypeGraph {
+				return r
+			}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			call, ok := unwrapParens(expr).(*parser.Call)
+			if ok && (call.Func.Name == "absent" || call.Func.Name == "absent_over_time") {
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' graphs %s(), which is either 1 or returns nothing, consider a stat or state timeline panel, or graphing the series itself", t.RefId, call.Func.Name))
+			}
+			return r
+		},
+	}
+}
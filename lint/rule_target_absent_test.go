@@ -0,0 +1,62 @@
+package lint
+
+import "testing"
+
+func TestAbsentRule(t *testing.T) {
+	linter := NewAbsentRule()
+
+	for _, tc := range []struct {
+		result    Result
+		panelType string
+		expr      string
+	}{
+		{
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			expr:      `up{job="api"}`,
+		},
+		{
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			expr:      `count(up{job="api"}) or absent(up{job="api"}) - 1`,
+		},
+		{
+			result:    ResultSuccess,
+			panelType: "stat",
+			expr:      `absent(up{job="api"})`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' graphs absent(), which is either 1 or returns nothing, consider a stat or state timeline panel, or graphing the series itself",
+			},
+			panelType: "timeseries",
+			expr:      `absent(up{job="api"})`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' graphs absent_over_time(), which is either 1 or returns nothing, consider a stat or state timeline panel, or graphing the series itself",
+			},
+			panelType: "graph",
+			expr:      `(absent_over_time(up{job="api"}[5m]))`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    tc.panelType,
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
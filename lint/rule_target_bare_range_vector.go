@@ -0,0 +1,38 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewBareRangeVectorRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-bare-range-vector-rule",
+		description: "Checks that timeseries queries do not return a range vector.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			// Instant queries may return a range vector, e.g. to list the raw samples in a table.
+			if p.Type != panelTypeTimeSeries && p.Type != panelTypeGraph {
+				return r
+			}
+			if t.Instant && !t.Range {
+				return r
+			}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			if _, ok := unwrapParens(expr).(*parser.MatrixSelector); ok {
+				r.AddError(d, p, t, fmt.Sprintf("refId '%s' returns a range vector, which can't be graphed, wrap it in a function such as rate() or avg_over_time()", t.RefId))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package lint
+
+import "testing"
+
+func TestBareRangeVectorRule(t *testing.T) {
+	linter := NewBareRangeVectorRule()
+
+	for _, tc := range []struct {
+		result    Result
+		panelType string
+		target    Target
+	}{
+		{
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			target:    Target{Expr: `sum(rate(http_requests_total[5m]))`},
+		},
+		{
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			target:    Target{Expr: `http_requests_total[5m] offset 1h`, Instant: true},
+		},
+		{
+			result:    ResultSuccess,
+			panelType: "table",
+			target:    Target{Expr: `http_requests_total[5m]`},
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' returns a range vector, which can't be graphed, wrap it in a function such as rate() or avg_over_time()",
+			},
+			panelType: "timeseries",
+			target:    Target{Expr: `http_requests_total[5m]`},
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' returns a range vector, which can't be graphed, wrap it in a function such as rate() or avg_over_time()",
+			},
+			panelType: "graph",
+			target:    Target{Expr: `(up{job="api"}[$__rate_interval])`},
+		},
+	} {
+		tc.target.RefId = "A"
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    tc.panelType,
+					Title:   "bar",
+					Targets: []Target{tc.target},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
@@ -0,0 +1,27 @@
+package lint
+
+import "fmt"
+
+func NewBlankLegendRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-blank-legend-rule",
+		description: "Checks that legend formats do not reference labels of queries which return no labels.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if !legendTokenRegexp.MatchString(t.LegendFormat) || targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			if labels, known := promQLOutputLabels(expr); known && len(labels) == 0 {
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' legend '%s' references labels, but the query aggregates all labels away, so the legend is blank", t.RefId, t.LegendFormat))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package lint
+
+import "testing"
+
+func TestBlankLegendRule(t *testing.T) {
+	linter := NewBlankLegendRule()
+
+	for _, tc := range []struct {
+		name   string
+		result Result
+		target Target
+	}{
+		{
+			name:   "no legend",
+			result: ResultSuccess,
+			target: Target{Expr: `sum(rate(http_requests_total[5m]))`},
+		},
+		{
+			name:   "static legend",
+			result: ResultSuccess,
+			target: Target{Expr: `sum(rate(http_requests_total[5m]))`, LegendFormat: "Requests"},
+		},
+		{
+			name:   "grouped",
+			result: ResultSuccess,
+			target: Target{Expr: `sum by (job) (rate(http_requests_total[5m]))`, LegendFormat: "{{job}}"},
+		},
+		{
+			name:   "unaggregated",
+			result: ResultSuccess,
+			target: Target{Expr: `rate(http_requests_total[5m])`, LegendFormat: "{{job}}"},
+		},
+		{
+			name: "fully aggregated",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' legend '{{job}} requests' references labels, but the query aggregates all labels away, so the legend is blank",
+			},
+			target: Target{Expr: `sum(rate(http_requests_total[5m]))`, LegendFormat: "{{job}} requests"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.target.RefId = "A"
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{{Type: "datasource", Query: "prometheus"}},
+				},
+				Panels: []Panel{
+					{
+						Type:    "timeseries",
+						Title:   "bar",
+						Targets: []Target{tc.target},
+					},
+				},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
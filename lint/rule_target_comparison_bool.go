@@ -0,0 +1,34 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// NewComparisonBoolRule is not part of the default rule set, as a filtering comparison is often intended.
+func NewComparisonBoolRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-comparison-bool-rule",
+		description: "Checks that top-level comparisons in timeseries panels use the bool modifier.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if p.Type != panelTypeTimeSeries || targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			binary, ok := unwrapParens(expr).(*parser.BinaryExpr)
+			if !ok || !binary.Op.IsComparisonOperator() || binary.ReturnBool {
+				return r
+			}
+			r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' uses comparison operator '%s' without the bool modifier, which filters series rather than returning 0 or 1", t.RefId, binary.Op))
+			return r
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package lint
+
+import "testing"
+
+func TestComparisonBoolRule(t *testing.T) {
+	linter := NewComparisonBoolRule()
+
+	for _, tc := range []struct {
+		result    Result
+		panelType string
+		expr      string
+	}{
+		{
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			expr:      `up == bool 1`,
+		},
+		{
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			expr:      `sum(rate(foo_total[5m])) / sum(rate(bar_total[5m]))`,
+		},
+		{
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			expr:      `sum(up == 1)`,
+		},
+		{
+			result:    ResultSuccess,
+			panelType: "table",
+			expr:      `up == 0`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses comparison operator '==' without the bool modifier, which filters series rather than returning 0 or 1",
+			},
+			panelType: "timeseries",
+			expr:      `up == 1`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses comparison operator '>' without the bool modifier, which filters series rather than returning 0 or 1",
+			},
+			panelType: "timeseries",
+			expr:      `(rate(errors_total[5m]) > 0)`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    tc.panelType,
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
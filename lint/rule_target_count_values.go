@@ -0,0 +1,44 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewCountValuesRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-count-values-rule",
+		description: "Checks that count_values is given a valid label name.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			// A missing label argument is a parse error, which target-promql-rule reports.
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				return r
+			}
+
+			parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+				agg, ok := node.(*parser.AggregateExpr)
+				if !ok || agg.Op != parser.COUNT_VALUES {
+					return nil
+				}
+				label, ok := agg.Param.(*parser.StringLiteral)
+				if !ok {
+					return nil
+				}
+				if label.Val == "" {
+					r.AddError(d, p, t, fmt.Sprintf("refId '%s' uses count_values without a label name", t.RefId))
+				} else if !labelNameRegexp.MatchString(label.Val) {
+					r.AddError(d, p, t, fmt.Sprintf("refId '%s' uses count_values with invalid label name '%s'", t.RefId, label.Val))
+				}
+				return nil
+			})
+			return r
+		},
+	}
+}
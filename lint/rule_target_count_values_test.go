@@ -0,0 +1,57 @@
+package lint
+
+import "testing"
+
+func TestCountValuesRule(t *testing.T) {
+	linter := NewCountValuesRule()
+
+	for _, tc := range []struct {
+		result Result
+		expr   string
+	}{
+		{
+			result: ResultSuccess,
+			expr:   `count_values("version", build_info)`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `sum(count_values by (job) ("version", build_info))`,
+		},
+		{
+			// Reported by target-promql-rule
+			result: ResultSuccess,
+			expr:   `count_values(build_info)`,
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses count_values without a label name",
+			},
+			expr: `count_values("", build_info)`,
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses count_values with invalid label name 'build-version'",
+			},
+			expr: `count_values("build-version", build_info)`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
@@ -0,0 +1,28 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// datasourceMacroRegexp matches the SQL, InfluxQL and Flux macros which have no meaning in PromQL. Longer
+// macros sharing a prefix must come first, as the leftmost alternative wins.
+var datasourceMacroRegexp = regexp.MustCompile(`\$(__timeFilter|__timeGroupAlias|__timeGroup|__timeFrom|__timeTo|__unixEpochFilter|__unixEpochGroupAlias|__unixEpochGroup|timeFilter)\b|\|>`)
+
+func NewDatasourceMacroRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-datasource-macro-rule",
+		description: "Checks that Prometheus targets do not use SQL or Flux macros.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			for _, macro := range datasourceMacroRegexp.FindAllString(t.Expr, -1) {
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' uses '%s', which is not supported by Prometheus", t.RefId, macro))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package lint
+
+import "testing"
+
+func TestDatasourceMacroRule(t *testing.T) {
+	linter := NewDatasourceMacroRule()
+
+	for _, tc := range []struct {
+		name       string
+		result     []Result
+		datasource interface{}
+		expr       string
+	}{
+		{
+			name:       "valid PromQL",
+			result:     []Result{ResultSuccess},
+			datasource: map[string]interface{}{"uid": "$datasource", "type": "prometheus"},
+			expr:       `sum(rate(foo_total[$__rate_interval]))`,
+		},
+		{
+			name: "SQL macro",
+			result: []Result{{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses '$__timeFilter', which is not supported by Prometheus",
+			}},
+			datasource: map[string]interface{}{"uid": "$datasource", "type": "prometheus"},
+			expr:       `sum(foo{$__timeFilter(time)})`,
+		},
+		{
+			name: "multiple macros",
+			result: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses '$__timeGroupAlias', which is not supported by Prometheus",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses '|>', which is not supported by Prometheus",
+				},
+			},
+			datasource: map[string]interface{}{"uid": "$datasource", "type": "prometheus"},
+			expr:       `$__timeGroupAlias(time, 1m) |> foo`,
+		},
+		{
+			name:       "SQL datasource",
+			result:     []Result{ResultSuccess},
+			datasource: map[string]interface{}{"uid": "mysql", "type": "mysql"},
+			expr:       `SELECT * FROM foo WHERE $__timeFilter(time)`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Panels: []Panel{
+					{
+						Type:  "timeseries",
+						Title: "bar",
+						Targets: []Target{
+							{
+								Datasource: tc.datasource,
+								RefId:      "A",
+								Expr:       tc.expr,
+							},
+						},
+					},
+				},
+			}
+			testMultiResultRule(t, linter, d, tc.result)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewDerivCounterRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-deriv-counter-rule",
+		description: "Checks that deriv and predict_linear are not applied to counters.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+				call, ok := node.(*parser.Call)
+				if !ok || (call.Func.Name != "deriv" && call.Func.Name != "predict_linear") || len(call.Args) == 0 {
+					return nil
+				}
+				// Counters wrapped in rate() are passed as a subquery, and are fine.
+				matrix, ok := unwrapParens(call.Args[0]).(*parser.MatrixSelector)
+				if !ok {
+					return nil
+				}
+				vs, ok := matrix.VectorSelector.(*parser.VectorSelector)
+				if !ok {
+					return nil
+				}
+				name := selectorMetricName(vs)
+				if !strings.HasSuffix(name, "_total") {
+					return nil
+				}
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' applies %s to the counter '%s', which is meant for gauges, use rate() instead", t.RefId, call.Func.Name, name))
+				return nil
+			})
+			return r
+		},
+	}
+}
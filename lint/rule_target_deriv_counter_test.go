@@ -0,0 +1,63 @@
+package lint
+
+import "testing"
+
+func TestDerivCounterRule(t *testing.T) {
+	linter := NewDerivCounterRule()
+
+	for _, tc := range []struct {
+		result Result
+		expr   string
+	}{
+		{
+			result: ResultSuccess,
+			expr:   `deriv(node_filesystem_avail_bytes[1h])`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `predict_linear(rate(http_requests_total[5m])[1h:], 3600)`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `rate(http_requests_total[5m])`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' applies deriv to the counter 'http_requests_total', which is meant for gauges, use rate() instead",
+			},
+			expr: `sum(deriv(http_requests_total{job="api"}[5m]))`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' applies predict_linear to the counter 'node_network_receive_bytes_total', which is meant for gauges, use rate() instead",
+			},
+			expr: `predict_linear((node_network_receive_bytes_total[$__range]), 86400)`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' applies deriv to the counter 'http_requests_total', which is meant for gauges, use rate() instead",
+			},
+			expr: `deriv({__name__="http_requests_total", job="api"}[5m])`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
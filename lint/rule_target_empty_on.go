@@ -0,0 +1,50 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewEmptyOnRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-empty-on-rule",
+		description: "Checks that binary operations matching on() with no labels have a single series on the one side.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+				binary, ok := node.(*parser.BinaryExpr)
+				if !ok || binary.VectorMatching == nil || !binary.VectorMatching.On || len(binary.VectorMatching.MatchingLabels) > 0 {
+					return nil
+				}
+
+				// on() matches every series of one side with every series of the other, which only works when
+				// the side each series is matched with has a single series.
+				var multiple bool
+				switch binary.VectorMatching.Card {
+				case parser.CardOneToOne:
+					multiple = !isSingleSeries(binary.LHS) || !isSingleSeries(binary.RHS)
+				case parser.CardManyToOne:
+					multiple = !isSingleSeries(binary.RHS)
+				case parser.CardOneToMany:
+					multiple = !isSingleSeries(binary.LHS)
+				}
+				if multiple {
+					r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' matches on() with no labels, but its operands may return several series, which fails with a matching error, list the labels to match on or aggregate the operands", t.RefId))
+				}
+				return nil
+			})
+			return r
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package lint
+
+import "testing"
+
+func TestEmptyOnRule(t *testing.T) {
+	linter := NewEmptyOnRule()
+
+	warning := Result{
+		Severity: Warning,
+		Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' matches on() with no labels, but its operands may return several series, which fails with a matching error, list the labels to match on or aggregate the operands",
+	}
+
+	for _, tc := range []struct {
+		result Result
+		expr   string
+	}{
+		{
+			result: ResultSuccess,
+			expr:   `sum(rate(http_requests_total[5m])) / on() sum(rate(http_requests_total[1h]))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `rate(http_requests_total[5m]) / on() group_left sum(rate(http_requests_total[5m]))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `rate(http_requests_total[5m]) / on(job) group_left rate(process_start_time_seconds[5m])`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `sum(rate(http_requests_total[5m])) / on() group_right rate(http_requests_total[5m])`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `up and on() vector(1)`,
+		},
+		{
+			result: warning,
+			expr:   `rate(http_request_errors_total[5m]) / on() rate(http_requests_total[5m])`,
+		},
+		{
+			result: warning,
+			expr:   `sum by (job) (rate(http_requests_total[5m])) / on() group_right rate(http_requests_total[5m])`,
+		},
+		{
+			result: warning,
+			expr:   `rate(http_requests_total[5m]) / on() group_left sum by (job) (rate(http_requests_total[5m]))`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
@@ -0,0 +1,23 @@
+package lint
+
+import "fmt"
+
+func NewExprLengthRule() *TargetRuleFunc {
+	return NewExprLengthRuleWithThreshold(1000)
+}
+
+// NewExprLengthRuleWithThreshold is like NewExprLengthRule, but allows the maximum expression length to
+// be configured.
+func NewExprLengthRuleWithThreshold(maxLength int) *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-expr-length-rule",
+		description: "Checks that target expressions are not excessively long.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if len(t.Expr) > maxLength {
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' expression is %d characters long, which exceeds %d, consider using a recording rule", t.RefId, len(t.Expr), maxLength))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExprLengthRule(t *testing.T) {
+	long := "sum(" + strings.Repeat("rate(foo_total[5m]) + ", 50) + "rate(foo_total[5m]))"
+
+	for _, tc := range []struct {
+		linter Rule
+		result Result
+		expr   string
+	}{
+		{
+			linter: NewExprLengthRule(),
+			result: ResultSuccess,
+			expr:   `sum(rate(foo_total[5m]))`,
+		},
+		{
+			linter: NewExprLengthRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' expression is 1124 characters long, which exceeds 1000, consider using a recording rule",
+			},
+			expr: long,
+		},
+		{
+			linter: NewExprLengthRuleWithThreshold(2000),
+			result: ResultSuccess,
+			expr:   long,
+		},
+		{
+			linter: NewExprLengthRuleWithThreshold(10),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' expression is 24 characters long, which exceeds 10, consider using a recording rule",
+			},
+			expr: `sum(rate(foo_total[5m]))`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, tc.linter, d, tc.result)
+	}
+}
@@ -0,0 +1,103 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+const (
+	metricTypeCounter = "counter"
+	metricTypeGauge   = "gauge"
+)
+
+// defaultMetricTypeSuffixes follows the Prometheus naming conventions.
+var defaultMetricTypeSuffixes = map[string]string{
+	"_total": metricTypeCounter,
+	"_info":  metricTypeGauge,
+	"_bytes": metricTypeGauge,
+	"_ratio": metricTypeGauge,
+}
+
+// metricTypeFunctions are the functions which only make sense for one type of metric. deriv and
+// predict_linear are checked by target-deriv-counter-rule instead.
+var metricTypeFunctions = map[string]string{
+	"rate":          metricTypeCounter,
+	"irate":         metricTypeCounter,
+	"increase":      metricTypeCounter,
+	"resets":        metricTypeCounter,
+	"avg_over_time": metricTypeGauge,
+	"min_over_time": metricTypeGauge,
+	"max_over_time": metricTypeGauge,
+	"sum_over_time": metricTypeGauge,
+	"delta":         metricTypeGauge,
+	"idelta":        metricTypeGauge,
+}
+
+func NewGaugeCounterSemanticsRule() *TargetRuleFunc {
+	return NewGaugeCounterSemanticsRuleWithSuffixes(defaultMetricTypeSuffixes)
+}
+
+// NewGaugeCounterSemanticsRuleWithSuffixes is like NewGaugeCounterSemanticsRule, but allows the metric
+// name suffixes used to guess the type of a metric to be configured. Each suffix maps to either "counter"
+// or "gauge", and the longest matching suffix wins.
+func NewGaugeCounterSemanticsRuleWithSuffixes(suffixes map[string]string) *TargetRuleFunc {
+	ordered := make([]string, 0, len(suffixes))
+	for suffix := range suffixes {
+		ordered = append(ordered, suffix)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return len(ordered[i]) > len(ordered[j])
+	})
+
+	return &TargetRuleFunc{
+		name:        "target-gauge-counter-rule",
+		description: "Checks that counter functions are applied to counters, and gauge functions to gauges.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+				call, ok := node.(*parser.Call)
+				if !ok || len(call.Args) == 0 {
+					return nil
+				}
+				want, ok := metricTypeFunctions[call.Func.Name]
+				if !ok {
+					return nil
+				}
+				matrix, ok := call.Args[0].(*parser.MatrixSelector)
+				if !ok {
+					return nil
+				}
+				selector, ok := matrix.VectorSelector.(*parser.VectorSelector)
+				if !ok {
+					return nil
+				}
+
+				name := selectorMetricName(selector)
+				for _, suffix := range ordered {
+					if !strings.HasSuffix(name, suffix) {
+						continue
+					}
+					if got := suffixes[suffix]; got != want {
+						r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' applies %s() to '%s', which looks like a %s", t.RefId, call.Func.Name, name, got))
+					}
+					break
+				}
+				return nil
+			})
+			return r
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package lint
+
+import "testing"
+
+func TestGaugeCounterSemanticsRule(t *testing.T) {
+	for _, tc := range []struct {
+		linter Rule
+		result Result
+		expr   string
+	}{
+		{
+			linter: NewGaugeCounterSemanticsRule(),
+			result: ResultSuccess,
+			expr:   `sum(rate(http_requests_total[5m]))`,
+		},
+		{
+			linter: NewGaugeCounterSemanticsRule(),
+			result: ResultSuccess,
+			expr:   `max_over_time(process_resident_memory_bytes[1h])`,
+		},
+		{
+			linter: NewGaugeCounterSemanticsRule(),
+			result: ResultSuccess,
+			expr:   `rate(network_transmit_bytes_total[5m])`,
+		},
+		{
+			linter: NewGaugeCounterSemanticsRule(),
+			result: ResultSuccess,
+			expr:   `avg_over_time(up[5m])`,
+		},
+		{
+			linter: NewGaugeCounterSemanticsRule(),
+			result: ResultSuccess,
+			expr:   `max_over_time(rate(http_requests_total[5m])[1h:])`,
+		},
+		{
+			// Reported by target-deriv-counter-rule.
+			linter: NewGaugeCounterSemanticsRule(),
+			result: ResultSuccess,
+			expr:   `deriv(http_requests_total[5m])`,
+		},
+		{
+			linter: NewGaugeCounterSemanticsRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' applies rate() to 'process_resident_memory_bytes', which looks like a gauge",
+			},
+			expr: `rate(process_resident_memory_bytes[5m])`,
+		},
+		{
+			linter: NewGaugeCounterSemanticsRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' applies avg_over_time() to 'http_requests_total', which looks like a counter",
+			},
+			expr: `sum(avg_over_time(http_requests_total{job="api"}[5m]))`,
+		},
+		{
+			linter: NewGaugeCounterSemanticsRuleWithSuffixes(map[string]string{"_count": "counter"}),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' applies max_over_time() to 'jobs_count', which looks like a counter",
+			},
+			expr: `max_over_time(jobs_count[5m])`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, tc.linter, d, tc.result)
+	}
+}
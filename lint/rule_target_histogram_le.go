@@ -0,0 +1,46 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewHistogramLeRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-histogram-le-rule",
+		description: "Checks that sum and avg aggregations of histogram buckets preserve the le label.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			for _, agg := range bucketAggregations(expr) {
+				if agg.Op != parser.SUM && agg.Op != parser.AVG {
+					continue
+				}
+				// Dropping le with without() is handled separately.
+				if agg.Without {
+					continue
+				}
+				preserved := false
+				for _, l := range agg.Grouping {
+					if l == "le" {
+						preserved = true
+					}
+				}
+				if !preserved {
+					r.AddError(d, p, t, fmt.Sprintf("refId '%s' aggregates histogram buckets with '%s' but does not preserve the 'le' label", t.RefId, agg.Op))
+				}
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package lint
+
+import "testing"
+
+func TestHistogramLeRule(t *testing.T) {
+	linter := NewHistogramLeRule()
+
+	for _, tc := range []struct {
+		result Result
+		expr   string
+	}{
+		{
+			result: ResultSuccess,
+			expr:   `histogram_quantile(0.99, sum by (le) (rate(foo_bucket[5m])))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `histogram_quantile(0.99, sum by (job, le) (rate(foo_bucket[5m])))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `histogram_quantile(0.99, sum without (instance) (rate(foo_bucket[5m])))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `avg(histogram_quantile(0.99, rate(foo_bucket[5m])))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `sum(rate(foo_count[5m]))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `sum(rate(foo_bucket{le="0.5"}[5m])) / sum(rate(foo_count[5m]))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `sum(foo_bucket{le="0.5"})`,
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' aggregates histogram buckets with 'sum' but does not preserve the 'le' label",
+			},
+			expr: `histogram_quantile(0.99, sum(rate(foo_bucket[5m])))`,
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' aggregates histogram buckets with 'avg' but does not preserve the 'le' label",
+			},
+			expr: `avg by (job) (rate({__name__="foo_bucket"}[5m]))`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
@@ -0,0 +1,93 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// captureGroupRegexp matches capture group references in a replacement, in the $1, ${1} and $name forms.
+var captureGroupRegexp = regexp.MustCompile(`\$(?:\{([[:word:]]+)\}|([[:word:]]+))`)
+
+func NewLabelReplaceRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-label-replace-rule",
+		description: "Checks that label_replace calls use a valid regex, and only reference its capture groups.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+				call, ok := node.(*parser.Call)
+				if !ok || call.Func.Name != "label_replace" || len(call.Args) != 5 {
+					return nil
+				}
+				replacement, ok := call.Args[2].(*parser.StringLiteral)
+				if !ok {
+					return nil
+				}
+				regex, ok := call.Args[4].(*parser.StringLiteral)
+				if !ok || hasVariableReference(regex.Val) {
+					return nil
+				}
+
+				re, err := regexp.Compile(regex.Val)
+				if err != nil {
+					r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' label_replace regex '%s' is invalid: %v", t.RefId, regex.Val, err))
+					return nil
+				}
+				for _, group := range missingCaptureGroups(re, replacement.Val) {
+					r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' label_replace replacement '%s' references capture group '%s', which regex '%s' does not have", t.RefId, replacement.Val, group, regex.Val))
+				}
+				return nil
+			})
+			return r
+		},
+	}
+}
+
+// hasVariableReference returns true if s references a dashboard variable, other than a numeric reference
+// such as a capture group.
+func hasVariableReference(s string) bool {
+	for _, name := range referencedVariables(s) {
+		if _, err := strconv.Atoi(name); err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// missingCaptureGroups returns the capture groups referenced in replacement which re does not have.
+func missingCaptureGroups(re *regexp.Regexp, replacement string) []string {
+	var missing []string
+	for _, match := range captureGroupRegexp.FindAllStringSubmatchIndex(replacement, -1) {
+		// $$ is an escaped $, which can't start a reference.
+		if match[0] > 0 && replacement[match[0]-1] == '$' {
+			continue
+		}
+		var group string
+		if match[2] >= 0 {
+			group = replacement[match[2]:match[3]]
+		} else {
+			group = replacement[match[4]:match[5]]
+		}
+		if i, err := strconv.Atoi(group); err == nil {
+			if i > re.NumSubexp() {
+				missing = append(missing, group)
+			}
+		} else if re.SubexpIndex(group) < 0 {
+			missing = append(missing, group)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,75 @@
+package lint
+
+import "testing"
+
+func TestLabelReplaceRule(t *testing.T) {
+	linter := NewLabelReplaceRule()
+
+	for _, tc := range []struct {
+		name    string
+		results []Result
+		expr    string
+	}{
+		{
+			name:    "valid",
+			results: []Result{ResultSuccess},
+			expr:    `label_replace(up, "host", "$1", "instance", "(.*):\\d+")`,
+		},
+		{
+			name:    "named group",
+			results: []Result{ResultSuccess},
+			expr:    `label_replace(up, "host", "${host}-$port", "instance", "(?P<host>.*):(?P<port>\\d+)")`,
+		},
+		{
+			name:    "escaped dollar",
+			results: []Result{ResultSuccess},
+			expr:    `label_replace(up, "cost", "$$5", "instance", ".*")`,
+		},
+		{
+			name:    "variable in regex",
+			results: []Result{ResultSuccess},
+			expr:    `label_replace(up, "host", "$1", "instance", "$host_regex")`,
+		},
+		{
+			name: "invalid regex",
+			results: []Result{{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' label_replace regex '(.*' is invalid: error parsing regexp: missing closing ): `(.*`",
+			}},
+			expr: `label_replace(up, "host", "$1", "instance", "(.*")`,
+		},
+		{
+			name: "missing groups",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' label_replace replacement '$1-${2}-$name' references capture group '2', which regex '(.*):.*' does not have",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' label_replace replacement '$1-${2}-$name' references capture group 'name', which regex '(.*):.*' does not have",
+				},
+			},
+			expr: `label_replace(up, "host", "$1-${2}-$name", "instance", "(.*):.*")`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{{Type: "datasource", Query: "prometheus"}},
+				},
+				Panels: []Panel{
+					{
+						Type:    "timeseries",
+						Title:   "bar",
+						Targets: []Target{{RefId: "A", Expr: tc.expr}},
+					},
+				},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	legendTokenRegexp = regexp.MustCompile(`\{\{(.*?)\}\}`)
+	labelNameRegexp   = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+func NewLegendTokenSyntaxRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-legend-token-rule",
+		description: "Checks that {{ }} tokens in legend formats reference valid label names.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			for _, match := range legendTokenRegexp.FindAllStringSubmatch(t.LegendFormat, -1) {
+				if !labelNameRegexp.MatchString(strings.TrimSpace(match[1])) {
+					r.AddError(d, p, t, fmt.Sprintf("refId '%s' legend format token '%s' is not a valid label name", t.RefId, match[0]))
+				}
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package lint
+
+import "testing"
+
+func TestLegendTokenSyntaxRule(t *testing.T) {
+	linter := NewLegendTokenSyntaxRule()
+
+	for _, tc := range []struct {
+		result       Result
+		legendFormat string
+	}{
+		{
+			result: ResultSuccess,
+		},
+		{
+			result:       ResultSuccess,
+			legendFormat: "{{job}}",
+		},
+		{
+			result:       ResultSuccess,
+			legendFormat: "{{ pod }} - {{container_name}}",
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' legend format token '{{ pod name }}' is not a valid label name",
+			},
+			legendFormat: "{{ pod name }}",
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' legend format token '{{status-code}}' is not a valid label name",
+			},
+			legendFormat: "{{job}}: {{status-code}}",
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' legend format token '{{}}' is not a valid label name",
+			},
+			legendFormat: "{{}}",
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: "up", LegendFormat: tc.legendFormat}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
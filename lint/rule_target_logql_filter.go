@@ -0,0 +1,79 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/grafana/loki/v3/pkg/logql/log"
+	"github.com/grafana/loki/v3/pkg/logql/syntax"
+)
+
+// broadStreamLabels are labels which usually select many log streams, so that a query only selecting by
+// them scans a large share of all logs.
+var broadStreamLabels = map[string]struct{}{
+	"job":       {},
+	"namespace": {},
+}
+
+func NewLogQLFilterRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-logql-filter-rule",
+		description: "Checks that Loki queries selecting only by job or namespace have a line filter.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if t.Hide || targetDatasourceType(d, p, t) != Loki {
+				return r
+			}
+
+			expr, err := parseLogQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid LogQL is another rule
+				return r
+			}
+
+			broad := false
+			Inspect(expr, func(node syntax.Expr) bool {
+				switch n := node.(type) {
+				case *syntax.PipelineExpr:
+					if !hasLineFilter(n.MultiStages) && isBroadSelector(n.Left) {
+						broad = true
+					}
+					return false
+				case *syntax.MatchersExpr:
+					if isBroadSelector(n) {
+						broad = true
+					}
+					return false
+				}
+				return true
+			})
+			if broad {
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' selects log streams only by job or namespace, without a line filter, so every log line of them is scanned", t.RefId))
+			}
+			return r
+		},
+	}
+}
+
+// hasLineFilter returns true if stages contain a line filter, other than an empty one matching every line.
+func hasLineFilter(stages syntax.MultiStageExpr) bool {
+	for _, stage := range stages {
+		filter, ok := stage.(*syntax.LineFilterExpr)
+		if !ok {
+			continue
+		}
+		if (filter.Ty == log.LineMatchEqual || filter.Ty == log.LineMatchRegexp) && filter.Match == "" {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func isBroadSelector(selector *syntax.MatchersExpr) bool {
+	for _, m := range selector.Matchers() {
+		if _, ok := broadStreamLabels[m.Name]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package lint
+
+import "testing"
+
+func TestLogQLFilterRule(t *testing.T) {
+	linter := NewLogQLFilterRule()
+
+	for _, tc := range []struct {
+		result Result
+		target Target
+	}{
+		{
+			result: ResultSuccess,
+			target: Target{Expr: `{job="api"} |= "error"`},
+		},
+		{
+			result: ResultSuccess,
+			target: Target{Expr: `{job="api", container="server"}`},
+		},
+		{
+			result: ResultSuccess,
+			target: Target{Expr: `sum by (level) (count_over_time({namespace="prod", app="api"} | json [$__auto]))`},
+		},
+		{
+			result: ResultSuccess,
+			target: Target{Expr: `{job="api"}`, Hide: true},
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' selects log streams only by job or namespace, without a line filter, so every log line of them is scanned",
+			},
+			target: Target{Expr: `{job="api"}`},
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' selects log streams only by job or namespace, without a line filter, so every log line of them is scanned",
+			},
+			target: Target{Expr: `sum(count_over_time({namespace="prod", job=~"api|web"} |= "" | logfmt [$__auto]))`},
+		},
+	} {
+		tc.target.RefId = "A"
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "loki"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "logs",
+					Title:   "bar",
+					Targets: []Target{tc.target},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
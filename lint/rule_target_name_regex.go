@@ -0,0 +1,56 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewNameRegexRule() *TargetRuleFunc {
+	return NewNameRegexRuleWithAllowed()
+}
+
+// NewNameRegexRuleWithAllowed is like NewNameRegexRule, but allows specific approved __name__ patterns,
+// which must match the pattern in the query exactly.
+func NewNameRegexRuleWithAllowed(allowed ...string) *TargetRuleFunc {
+	approved := make(map[string]struct{}, len(allowed))
+	for _, pattern := range allowed {
+		approved[pattern] = struct{}{}
+	}
+
+	return &TargetRuleFunc{
+		name:        "target-name-regex-rule",
+		description: "Checks that selectors use a concrete metric name rather than a __name__ regex.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+				selector, ok := node.(*parser.VectorSelector)
+				if !ok {
+					return nil
+				}
+				for _, matcher := range selector.LabelMatchers {
+					if matcher.Name != labels.MetricName || matcher.Type != labels.MatchRegexp {
+						continue
+					}
+					if _, ok := approved[matcher.Value]; ok {
+						continue
+					}
+					r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' matches metric names with the regex '%s', use a concrete metric name instead", t.RefId, matcher.Value))
+				}
+				return nil
+			})
+			return r
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package lint
+
+import "testing"
+
+func TestNameRegexRule(t *testing.T) {
+	for _, tc := range []struct {
+		linter Rule
+		result Result
+		expr   string
+	}{
+		{
+			linter: NewNameRegexRule(),
+			result: ResultSuccess,
+			expr:   `sum(rate(http_requests_total{job="api"}[5m]))`,
+		},
+		{
+			linter: NewNameRegexRule(),
+			result: ResultSuccess,
+			expr:   `{__name__="http_requests_total", job="api"}`,
+		},
+		{
+			linter: NewNameRegexRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' matches metric names with the regex 'http_.*_total', use a concrete metric name instead",
+			},
+			expr: `sum(rate({__name__=~"http_.*_total", job="api"}[5m]))`,
+		},
+		{
+			linter: NewNameRegexRuleWithAllowed("http_.*_total"),
+			result: ResultSuccess,
+			expr:   `sum(rate({__name__=~"http_.*_total", job="api"}[5m]))`,
+		},
+		{
+			linter: NewNameRegexRuleWithAllowed("http_.*_total"),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' matches metric names with the regex 'grpc_.*', use a concrete metric name instead",
+			},
+			expr: `count({__name__=~"grpc_.*"})`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, tc.linter, d, tc.result)
+	}
+}
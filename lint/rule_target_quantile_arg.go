@@ -0,0 +1,111 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// quantileCallRegexp matches the start of histogram_quantile() and quantile() calls, including the grouping
+// clause quantile() may have before its arguments.
+var quantileCallRegexp = regexp.MustCompile(`\b(?:histogram_quantile|quantile(?:\s*(?:by|without)\s*\([^)]*\))?)\s*\(`)
+
+func NewQuantileArgRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-quantile-arg-rule",
+		description: "Checks that histogram_quantile and quantile are called with a quantile between 0 and 1.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			// Variables are expanded to sample values before parsing, so the first argument of each call is
+			// looked up in the original query to skip quantiles given by a variable. Both the scan of the
+			// query and parser.Inspect find the calls in the order they appear.
+			rawArgs := quantileArgs(t.Expr)
+			i := 0
+			parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+				var name string
+				var arg parser.Expr
+				switch n := node.(type) {
+				case *parser.Call:
+					if n.Func.Name != "histogram_quantile" || len(n.Args) == 0 {
+						return nil
+					}
+					name, arg = n.Func.Name, n.Args[0]
+				case *parser.AggregateExpr:
+					if n.Op != parser.QUANTILE {
+						return nil
+					}
+					name, arg = n.Op.String(), n.Param
+				default:
+					return nil
+				}
+				raw := ""
+				if i < len(rawArgs) {
+					raw = rawArgs[i]
+				}
+				i++
+				if len(rawArgs) != 0 && hasVariableReference(raw) {
+					return nil
+				}
+
+				number, ok := unwrapParens(arg).(*parser.NumberLiteral)
+				if !ok || (number.Val >= 0 && number.Val <= 1) {
+					return nil
+				}
+				message := fmt.Sprintf("refId '%s' calls %s with the quantile %g, which is outside of [0, 1]", t.RefId, name, number.Val)
+				if number.Val > 1 && number.Val <= 100 {
+					message += fmt.Sprintf(", did you mean %g?", number.Val/100)
+				}
+				r.AddError(d, p, t, message)
+				return nil
+			})
+			return r
+		},
+	}
+}
+
+// quantileArgs returns the unparsed first argument of each histogram_quantile() and quantile() call in expr,
+// in the order they appear.
+func quantileArgs(expr string) []string {
+	var args []string
+	for _, loc := range quantileCallRegexp.FindAllStringIndex(expr, -1) {
+		depth := 0
+		quote := rune(0)
+		end := len(expr)
+	scan:
+		for i, c := range expr[loc[1]:] {
+			switch {
+			case quote != 0:
+				if c == quote {
+					quote = 0
+				}
+			case c == '"' || c == '\'' || c == '`':
+				quote = c
+			case c == '(' || c == '{' || c == '[':
+				depth++
+			case c == ')' || c == '}' || c == ']':
+				if depth == 0 {
+					end = loc[1] + i
+					break scan
+				}
+				depth--
+			case c == ',' && depth == 0:
+				end = loc[1] + i
+				break scan
+			}
+		}
+		args = append(args, strings.TrimSpace(expr[loc[1]:end]))
+	}
+	return args
+}
@@ -0,0 +1,66 @@
+package lint
+
+import "testing"
+
+func TestQuantileArgRule(t *testing.T) {
+	linter := NewQuantileArgRule()
+
+	for _, tc := range []struct {
+		result Result
+		expr   string
+	}{
+		{
+			result: ResultSuccess,
+			expr:   `histogram_quantile(0.95, sum by (le) (rate(http_request_duration_seconds_bucket{quantile="95"}[5m])))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `quantile by (job) (0.5, rate(http_requests_total[5m]))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `histogram_quantile($quantile, sum by (le) (rate(http_request_duration_seconds_bucket[5m])))`,
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' calls histogram_quantile with the quantile 95, which is outside of [0, 1], did you mean 0.95?",
+			},
+			expr: `histogram_quantile(95, sum by (le) (rate(http_request_duration_seconds_bucket[5m])))`,
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' calls quantile with the quantile 99, which is outside of [0, 1], did you mean 0.99?",
+			},
+			expr: `histogram_quantile($quantile, sum by (le) (rate(http_request_duration_seconds_bucket[5m]))) / quantile without (instance) ((99), rate(http_requests_total[5m]))`,
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' calls quantile with the quantile -0.5, which is outside of [0, 1]",
+			},
+			expr: `quantile(-0.5, rate(http_requests_total[5m])) by (job)`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{
+					{Type: "datasource", Query: "prometheus"},
+					{Name: "quantile", Type: "custom", Current: RawTemplateValue{"value": "95"}},
+				},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
@@ -0,0 +1,77 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+// rateFunctions are the functions which need at least two samples within their range to return a result.
+var rateFunctions = map[string]struct{}{
+	"rate":     {},
+	"irate":    {},
+	"increase": {},
+	"delta":    {},
+	"idelta":   {},
+	"deriv":    {},
+}
+
+func NewRateRangeRule() *TargetRuleFunc {
+	return NewRateRangeRuleWithMinimum(time.Minute)
+}
+
+// NewRateRangeRuleWithMinimum is like NewRateRangeRule, but allows the shortest acceptable literal range
+// to be configured.
+func NewRateRangeRuleWithMinimum(minimum time.Duration) *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-rate-range-rule",
+		description: "Checks that rate-like functions do not use a literal range that is too short.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expanded, err := expandVariables(t.Expr, d.Templating.List)
+			if err != nil {
+				return r
+			}
+			expr, err := parser.ParseExpr(expanded)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			parser.Inspect(expr, func(node parser.Node, parents []parser.Node) error {
+				selector, ok := node.(*parser.MatrixSelector)
+				if !ok || selector.Range >= minimum || len(parents) == 0 {
+					return nil
+				}
+				call, ok := parents[len(parents)-1].(*parser.Call)
+				if !ok {
+					return nil
+				}
+				if _, ok := rateFunctions[call.Func.Name]; !ok {
+					return nil
+				}
+
+				// Ranges from variables are expanded to placeholder durations, so only report ranges
+				// which were written literally in the original expression.
+				pos := selector.PositionRange()
+				text := expanded[pos.Start:pos.End]
+				rng := text[strings.LastIndex(text, "["):]
+				rng = rng[:strings.Index(rng, "]")+1]
+				if !strings.Contains(t.Expr, rng) {
+					return nil
+				}
+
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' uses range '%s' in %s(), which is shorter than %s, consider using $__rate_interval", t.RefId, rng, call.Func.Name, model.Duration(minimum)))
+				return nil
+			})
+			return r
+		},
+	}
+}
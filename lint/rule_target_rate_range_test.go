@@ -0,0 +1,73 @@
+package lint
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateRangeRule(t *testing.T) {
+	for _, tc := range []struct {
+		linter Rule
+		result Result
+		expr   string
+	}{
+		{
+			linter: NewRateRangeRule(),
+			result: ResultSuccess,
+			expr:   `sum(rate(foo_total[5m]))`,
+		},
+		{
+			linter: NewRateRangeRule(),
+			result: ResultSuccess,
+			expr:   `sum(rate(foo_total[$__rate_interval]))`,
+		},
+		{
+			linter: NewRateRangeRule(),
+			result: ResultSuccess,
+			expr:   `sum(rate(foo_total[$__interval]))`,
+		},
+		{
+			linter: NewRateRangeRule(),
+			result: ResultSuccess,
+			expr:   `max_over_time(foo[15s])`,
+		},
+		{
+			linter: NewRateRangeRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses range '[15s]' in rate(), which is shorter than 1m, consider using $__rate_interval",
+			},
+			expr: `sum(rate(foo_total{job="$job"}[15s]))`,
+		},
+		{
+			linter: NewRateRangeRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses range '[30s]' in increase(), which is shorter than 1m, consider using $__rate_interval",
+			},
+			expr: `increase(foo_total[30s] offset 1h)`,
+		},
+		{
+			linter: NewRateRangeRuleWithMinimum(10 * time.Second),
+			result: ResultSuccess,
+			expr:   `rate(foo_total[15s])`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, tc.linter, d, tc.result)
+	}
+}
@@ -0,0 +1,24 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var refIdConventionRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9]*$`)
+
+// NewRefIdConventionRule is not part of the default rule set, as some datasources allow, or generate,
+// arbitrary refIds.
+func NewRefIdConventionRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-ref-id-convention-rule",
+		description: "Checks that target refIds follow Grafana's A, B, C convention.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if t.RefId != "" && !refIdConventionRegexp.MatchString(t.RefId) {
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' does not follow the A, B, C naming convention", t.RefId))
+			}
+			return r
+		},
+	}
+}
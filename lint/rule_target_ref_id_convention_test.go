@@ -0,0 +1,51 @@
+package lint
+
+import "testing"
+
+func TestRefIdConventionRule(t *testing.T) {
+	linter := NewRefIdConventionRule()
+
+	for _, tc := range []struct {
+		result Result
+		refId  string
+	}{
+		{
+			result: ResultSuccess,
+			refId:  "A",
+		},
+		{
+			result: ResultSuccess,
+			refId:  "AB2",
+		},
+		{
+			result: ResultSuccess,
+			refId:  "",
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'query1' does not follow the A, B, C naming convention",
+			},
+			refId: "query1",
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId '1A' does not follow the A, B, C naming convention",
+			},
+			refId: "1A",
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: tc.refId, Expr: `up`}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
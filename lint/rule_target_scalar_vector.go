@@ -0,0 +1,40 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewScalarVectorRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-scalar-vector-rule",
+		description: "Checks that timeseries panel targets are not wrapped in scalar().",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			// scalar() is appropriate for single value panels, such as stat panels.
+			if p.Type != panelTypeTimeSeries && p.Type != panelTypeGraph {
+				return r
+			}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			// vector(scalar(...)) turns the scalar back into a vector, but it still has no labels.
+			expr = unwrapParens(expr)
+			if call, ok := expr.(*parser.Call); ok && call.Func.Name == "vector" {
+				expr = unwrapParens(call.Args[0])
+			}
+			if call, ok := expr.(*parser.Call); ok && call.Func.Name == "scalar" {
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' is wrapped in scalar(), which collapses it to a single series without labels", t.RefId))
+			}
+			return r
+		},
+	}
+}
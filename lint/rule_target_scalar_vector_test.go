@@ -0,0 +1,70 @@
+package lint
+
+import "testing"
+
+func TestScalarVectorRule(t *testing.T) {
+	linter := NewScalarVectorRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		expr      string
+	}{
+		{
+			name:      "no scalar",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			expr:      `sum by (job) (rate(http_requests_total[5m]))`,
+		},
+		{
+			name:      "scalar in a binary expression",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			expr:      `rate(http_requests_total[5m]) / scalar(count(up))`,
+		},
+		{
+			name:      "stat panel",
+			result:    ResultSuccess,
+			panelType: "stat",
+			expr:      `scalar(sum(up))`,
+		},
+		{
+			name: "scalar",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' is wrapped in scalar(), which collapses it to a single series without labels",
+			},
+			panelType: "timeseries",
+			expr:      `scalar(sum by (job) (up))`,
+		},
+		{
+			name: "vector of scalar in graph panel",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' is wrapped in scalar(), which collapses it to a single series without labels",
+			},
+			panelType: "graph",
+			expr:      `(vector(scalar(up)))`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{{Type: "datasource", Query: "prometheus"}},
+				},
+				Panels: []Panel{
+					{
+						Type:    tc.panelType,
+						Title:   "bar",
+						Targets: []Target{{RefId: "A", Expr: tc.expr}},
+					},
+				},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
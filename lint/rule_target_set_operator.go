@@ -0,0 +1,41 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewSetOperatorRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-set-operator-rule",
+		description: "Checks that the and, or and unless operators are used with on() or ignoring().",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+				binary, ok := node.(*parser.BinaryExpr)
+				if !ok || !binary.Op.IsSetOperator() || binary.VectorMatching == nil {
+					return nil
+				}
+				// The parser can't tell an empty ignoring() apart from no clause at all, but both match on
+				// every label.
+				if binary.VectorMatching.On || len(binary.VectorMatching.MatchingLabels) > 0 {
+					return nil
+				}
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' uses set operator '%s' without on() or ignoring()", t.RefId, binary.Op))
+				return nil
+			})
+			return r
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package lint
+
+import "testing"
+
+func TestSetOperatorRule(t *testing.T) {
+	linter := NewSetOperatorRule()
+
+	for _, tc := range []struct {
+		result Result
+		expr   string
+	}{
+		{
+			result: ResultSuccess,
+			expr:   `sum(rate(foo_total[5m])) / sum(rate(bar_total[5m]))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `up and on (job, instance) node_boot_time_seconds`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `foo unless ignoring (code) bar`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses set operator 'and' without on() or ignoring()",
+			},
+			expr: `up and node_boot_time_seconds`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses set operator 'or' without on() or ignoring()",
+			},
+			expr: `sum by (job) (rate(foo_total[5m])) or vector(0)`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
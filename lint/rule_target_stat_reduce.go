@@ -0,0 +1,24 @@
+package lint
+
+import "fmt"
+
+func NewStatReduceRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-stat-reduce-rule",
+		description: "Checks that stat and gauge panels use instant queries.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if p.Type != panelTypeStat && p.Type != panelTypeGauge {
+				return r
+			}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			if t.Range || !t.Instant {
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' is a range query, but %s panels only show a single value, consider using an instant query", t.RefId, p.Type))
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package lint
+
+import "testing"
+
+func TestStatReduceRule(t *testing.T) {
+	linter := NewStatReduceRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		target    Target
+	}{
+		{
+			name:      "instant stat",
+			result:    ResultSuccess,
+			panelType: "stat",
+			target:    Target{RefId: "A", Expr: "up", Instant: true},
+		},
+		{
+			name:      "range timeseries",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			target:    Target{RefId: "A", Expr: "up", Range: true},
+		},
+		{
+			name: "range stat",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' is a range query, but stat panels only show a single value, consider using an instant query",
+			},
+			panelType: "stat",
+			target:    Target{RefId: "A", Expr: "up", Range: true},
+		},
+		{
+			name: "range and instant gauge",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' is a range query, but gauge panels only show a single value, consider using an instant query",
+			},
+			panelType: "gauge",
+			target:    Target{RefId: "A", Expr: "up", Range: true, Instant: true},
+		},
+		{
+			name: "unset defaults to range",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' is a range query, but stat panels only show a single value, consider using an instant query",
+			},
+			panelType: "stat",
+			target:    Target{RefId: "A", Expr: "up"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{{Type: "datasource", Query: "prometheus"}},
+				},
+				Panels: []Panel{
+					{
+						Type:    tc.panelType,
+						Title:   "bar",
+						Targets: []Target{tc.target},
+					},
+				},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package lint
+
+import "fmt"
+
+func NewSumWithoutLeRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-sum-without-le-rule",
+		description: "Checks that aggregations of histogram buckets do not drop the le label with without().",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			for _, agg := range bucketAggregations(expr) {
+				if !agg.Without {
+					continue
+				}
+				for _, l := range agg.Grouping {
+					if l == "le" {
+						r.AddError(d, p, t, fmt.Sprintf("refId '%s' aggregates histogram buckets with '%s without (le)', which drops the bucket boundaries", t.RefId, agg.Op))
+						break
+					}
+				}
+			}
+			return r
+		},
+	}
+}
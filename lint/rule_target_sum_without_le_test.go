@@ -0,0 +1,53 @@
+package lint
+
+import "testing"
+
+func TestSumWithoutLeRule(t *testing.T) {
+	linter := NewSumWithoutLeRule()
+
+	for _, tc := range []struct {
+		result Result
+		expr   string
+	}{
+		{
+			result: ResultSuccess,
+			expr:   `histogram_quantile(0.99, sum without (instance) (rate(http_request_duration_seconds_bucket[5m])))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `histogram_quantile(0.99, sum by (le) (rate(http_request_duration_seconds_bucket[5m])))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `sum without (le) (rate(http_request_duration_seconds_count[5m]))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `sum without (le) (rate(http_request_duration_seconds_bucket{le="0.5"}[5m]))`,
+		},
+		{
+			result: Result{
+				Severity: Error,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' aggregates histogram buckets with 'sum without (le)', which drops the bucket boundaries",
+			},
+			expr: `histogram_quantile(0.99, sum without (le, instance) (rate(http_request_duration_seconds_bucket[5m])))`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
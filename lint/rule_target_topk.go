@@ -0,0 +1,59 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewTopkRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-topk-rule",
+		description: "Checks that topk and bottomk are not used in range queries.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			// Instant queries, as used by stat panels, select the series once, which is what topk is for.
+			if p.Type != panelTypeTimeSeries && p.Type != panelTypeGraph {
+				return r
+			}
+			if t.Instant && !t.Range {
+				return r
+			}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+				agg, ok := node.(*parser.AggregateExpr)
+				if !ok || (agg.Op != parser.TOPK && agg.Op != parser.BOTTOMK) || hasAtModifier(agg.Expr) {
+					return nil
+				}
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' uses %s in a range query, which selects the series at each step separately, consider selecting them once for the whole range, e.g. with %s(5, avg_over_time(...[$__range] @ end()))", t.RefId, agg.Op, agg.Op))
+				return nil
+			})
+			return r
+		},
+	}
+}
+
+// hasAtModifier reports whether expr selects data at a fixed time with the @ modifier, in which case it
+// returns the same series at every step.
+func hasAtModifier(expr parser.Expr) bool {
+	found := false
+	parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+		switch n := node.(type) {
+		case *parser.VectorSelector:
+			found = found || n.Timestamp != nil || n.StartOrEnd != 0
+		case *parser.SubqueryExpr:
+			found = found || n.Timestamp != nil || n.StartOrEnd != 0
+		}
+		return nil
+	})
+	return found
+}
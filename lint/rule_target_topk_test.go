@@ -0,0 +1,77 @@
+package lint
+
+import "testing"
+
+func TestTopkRule(t *testing.T) {
+	linter := NewTopkRule()
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		panelType string
+		target    Target
+	}{
+		{
+			name:      "no topk",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			target:    Target{Expr: `sum by (job) (rate(http_requests_total[5m]))`},
+		},
+		{
+			name:      "stat panel",
+			result:    ResultSuccess,
+			panelType: "stat",
+			target:    Target{Expr: `topk(5, sum by (job) (rate(http_requests_total[5m])))`},
+		},
+		{
+			name:      "instant query",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			target:    Target{Expr: `topk(5, sum by (job) (rate(http_requests_total[5m])))`, Instant: true},
+		},
+		{
+			name:      "at modifier",
+			result:    ResultSuccess,
+			panelType: "timeseries",
+			target:    Target{Expr: `up and on (job) topk(5, sum by (job) (increase(http_requests_total[$__range] @ end())))`},
+		},
+		{
+			name: "topk",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses topk in a range query, which selects the series at each step separately, consider selecting them once for the whole range, e.g. with topk(5, avg_over_time(...[$__range] @ end()))",
+			},
+			panelType: "timeseries",
+			target:    Target{Expr: `topk(5, sum by (job) (rate(http_requests_total[5m])))`},
+		},
+		{
+			name: "nested bottomk",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses bottomk in a range query, which selects the series at each step separately, consider selecting them once for the whole range, e.g. with bottomk(5, avg_over_time(...[$__range] @ end()))",
+			},
+			panelType: "graph",
+			target:    Target{Expr: `sum(bottomk(3, up))`, Instant: true, Range: true},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.target.RefId = "A"
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{{Type: "datasource", Query: "prometheus"}},
+				},
+				Panels: []Panel{
+					{
+						Type:    tc.panelType,
+						Title:   "bar",
+						Targets: []Target{tc.target},
+					},
+				},
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
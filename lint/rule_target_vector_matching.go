@@ -0,0 +1,45 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/prometheus/prometheus/promql/parser"
+)
+
+func NewVectorMatchingRule() *TargetRuleFunc {
+	return &TargetRuleFunc{
+		name:        "target-vector-matching-rule",
+		description: "Checks that group_left and group_right are used with a non-empty on() or ignoring() label list.",
+		fn: func(d Dashboard, p Panel, t Target) TargetRuleResults {
+			r := TargetRuleResults{}
+			if targetDatasourceType(d, p, t) != Prometheus {
+				return r
+			}
+
+			expr, err := parsePromQL(t.Expr, d.Templating.List)
+			if err != nil {
+				// Invalid PromQL is another rule
+				return r
+			}
+
+			parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+				binary, ok := node.(*parser.BinaryExpr)
+				if !ok || binary.VectorMatching == nil || len(binary.VectorMatching.MatchingLabels) > 0 {
+					return nil
+				}
+				var group string
+				switch binary.VectorMatching.Card {
+				case parser.CardManyToOne:
+					group = "group_left"
+				case parser.CardOneToMany:
+					group = "group_right"
+				default:
+					return nil
+				}
+				r.AddWarning(d, p, t, fmt.Sprintf("refId '%s' uses %s with operator '%s' with an empty on() or ignoring() label list", t.RefId, group, binary.Op))
+				return nil
+			})
+			return r
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package lint
+
+import "testing"
+
+func TestVectorMatchingRule(t *testing.T) {
+	linter := NewVectorMatchingRule()
+
+	for _, tc := range []struct {
+		result Result
+		expr   string
+	}{
+		{
+			result: ResultSuccess,
+			expr:   `sum(rate(foo_total[5m])) / sum(rate(bar_total[5m]))`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `rate(foo_total[5m]) * on (job, instance) group_left (version) build_info`,
+		},
+		{
+			result: ResultSuccess,
+			expr:   `rate(foo_total[5m]) * ignoring (code) group_left bar`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses group_left with operator '*' with an empty on() or ignoring() label list",
+			},
+			expr: `rate(foo_total[5m]) * on () group_left (version) build_info`,
+		},
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test', panel 'bar', target idx '0' refId 'A' uses group_right with operator '/' with an empty on() or ignoring() label list",
+			},
+			expr: `foo / ignoring () group_right bar`,
+		},
+	} {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{{Type: "datasource", Query: "prometheus"}},
+			},
+			Panels: []Panel{
+				{
+					Type:    "timeseries",
+					Title:   "bar",
+					Targets: []Target{{RefId: "A", Expr: tc.expr}},
+				},
+			},
+		}
+		testRule(t, linter, d, tc.result)
+	}
+}
@@ -0,0 +1,36 @@
+package lint
+
+import "fmt"
+
+func NewAllValueUsageRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-all-value-usage-rule",
+		description: "Checks that multi-value variables used in queries include the All option.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			templates := map[string]Template{}
+			for _, t := range d.Templating.List {
+				templates[t.Name] = t
+			}
+
+			for _, p := range d.GetPanels() {
+				for _, t := range p.Targets {
+					reported := map[string]struct{}{}
+					for _, name := range referencedVariables(t.Expr) {
+						template, ok := templates[name]
+						if !ok || !template.Multi || template.IncludeAll {
+							continue
+						}
+						if _, ok := reported[name]; ok {
+							continue
+						}
+						reported[name] = struct{}{}
+						r.AddWarning(d, fmt.Sprintf("panel '%s' refId '%s' uses multi-value variable '%s', which does not include the All option", p.Title, t.RefId, name))
+					}
+				}
+			}
+			return r
+		},
+	}
+}
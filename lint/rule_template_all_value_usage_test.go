@@ -0,0 +1,64 @@
+package lint
+
+import "testing"
+
+func TestAllValueUsageRule(t *testing.T) {
+	linter := NewAllValueUsageRule()
+
+	templates := []Template{
+		{Name: "job", Type: "query", Multi: true, IncludeAll: true},
+		{Name: "instance", Type: "query", Multi: true},
+		{Name: "cluster", Type: "query"},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		results []Result
+		targets []Target
+	}{
+		{
+			name:    "include all",
+			results: []Result{ResultSuccess},
+			targets: []Target{{RefId: "A", Expr: `up{job=~"$job", cluster="$cluster"}`}},
+		},
+		{
+			name:    "unused",
+			results: []Result{ResultSuccess},
+			targets: []Target{{RefId: "A", Expr: `up`}},
+		},
+		{
+			name: "no include all",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' panel 'bar' refId 'A' uses multi-value variable 'instance', which does not include the All option",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' panel 'bar' refId 'B' uses multi-value variable 'instance', which does not include the All option",
+				},
+			},
+			targets: []Target{
+				{RefId: "A", Expr: `up{job=~"$job", instance=~"$instance"} or on (instance) absent(up{instance=~"${instance:regex}"})`},
+				{RefId: "B", Expr: `node_boot_time_seconds{instance=~"$instance"}`},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{List: templates},
+				Panels: []Panel{
+					{
+						Type:    "timeseries",
+						Title:   "bar",
+						Targets: tc.targets,
+					},
+				},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
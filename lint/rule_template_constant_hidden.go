@@ -0,0 +1,19 @@
+package lint
+
+import "fmt"
+
+func NewConstantVariableRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-constant-hidden-rule",
+		description: "Checks that constant template variables are hidden.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			for _, template := range d.GetTemplateByType("constant") {
+				if template.Hide != templateHideVariable {
+					r.AddWarning(d, fmt.Sprintf("constant variable named '%s' is not hidden", template.Name))
+				}
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConstantVariableRule(t *testing.T) {
+	linter := NewConstantVariableRule()
+
+	for _, tc := range []struct {
+		name      string
+		results   []Result
+		templates []Template
+	}{
+		{
+			name:    "hidden",
+			results: []Result{ResultSuccess},
+			templates: []Template{
+				{Name: "cluster", Type: "constant", Query: "prod", Hide: templateHideVariable},
+			},
+		},
+		{
+			name:    "not a constant variable",
+			results: []Result{ResultSuccess},
+			templates: []Template{
+				{Name: "job", Type: "query"},
+				{Name: "filter", Type: "textbox", Hide: templateHideLabel},
+			},
+		},
+		{
+			name: "visible",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' constant variable named 'cluster' is not hidden",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' constant variable named 'namespace' is not hidden",
+				},
+			},
+			templates: []Template{
+				{Name: "cluster", Type: "constant", Query: "prod"},
+				{Name: "region", Type: "constant", Query: "eu", Hide: templateHideVariable},
+				{Name: "namespace", Type: "constant", Query: "default", Hide: templateHideLabel},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{List: tc.templates},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
+
+func TestTemplateHideUnmarshal(t *testing.T) {
+	var template Template
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "cluster", "type": "constant", "query": "prod", "hide": 2}`), &template))
+	require.Equal(t, templateHideVariable, template.Hide)
+}
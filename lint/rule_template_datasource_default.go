@@ -0,0 +1,26 @@
+package lint
+
+import "fmt"
+
+func NewDatasourceVariableDefaultRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-datasource-default-rule",
+		description: "Checks that each templated datasource variable has a current default value.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			for _, template := range d.GetTemplateByType("datasource") {
+				current, err := template.Current.Get()
+				if err != nil {
+					r.AddError(d, fmt.Sprintf("templated datasource variable named '%s' has invalid current value: %v", template.Name, err))
+					continue
+				}
+				if current.Value == "" {
+					r.AddWarning(d, fmt.Sprintf("templated datasource variable named '%s' has no current value set, users will be prompted to select one", template.Name))
+				}
+			}
+
+			return r
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package lint
+
+import "testing"
+
+func TestDatasourceVariableDefaultRule(t *testing.T) {
+	linter := NewDatasourceVariableDefaultRule()
+
+	for _, tc := range []struct {
+		result    Result
+		dashboard Dashboard
+	}{
+		// Dashboards without a templated datasource are handled by another rule.
+		{
+			result: ResultSuccess,
+			dashboard: Dashboard{
+				Title: "test",
+			},
+		},
+		// Missing current value.
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' templated datasource variable named 'datasource' has no current value set, users will be prompted to select one",
+			},
+			dashboard: Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{
+						{
+							Name:  "datasource",
+							Type:  "datasource",
+							Query: "prometheus",
+						},
+					},
+				},
+			},
+		},
+		// Empty current value.
+		{
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' templated datasource variable named 'datasource' has no current value set, users will be prompted to select one",
+			},
+			dashboard: Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{
+						{
+							Name:    "datasource",
+							Type:    "datasource",
+							Query:   "prometheus",
+							Current: RawTemplateValue{"text": "", "value": ""},
+						},
+					},
+				},
+			},
+		},
+		// What success looks like.
+		{
+			result: ResultSuccess,
+			dashboard: Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{
+						{
+							Name:    "datasource",
+							Type:    "datasource",
+							Query:   "prometheus",
+							Current: RawTemplateValue{"text": "default", "value": "default"},
+						},
+					},
+				},
+			},
+		},
+	} {
+		testRule(t, linter, tc.dashboard, tc.result)
+	}
+}
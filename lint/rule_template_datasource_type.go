@@ -0,0 +1,19 @@
+package lint
+
+import "fmt"
+
+func NewDatasourceVarTypeRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-datasource-type-rule",
+		description: "Checks that datasource template variables filter the datasource type.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			for _, template := range d.GetTemplateByType("datasource") {
+				if template.Query == "" {
+					r.AddWarning(d, fmt.Sprintf("datasource variable named '%s' has no datasource type in its query, so it lists every datasource", template.Name))
+				}
+			}
+			return r
+		},
+	}
+}
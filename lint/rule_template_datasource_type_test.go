@@ -0,0 +1,52 @@
+package lint
+
+import "testing"
+
+func TestDatasourceVarTypeRule(t *testing.T) {
+	linter := NewDatasourceVarTypeRule()
+
+	for _, tc := range []struct {
+		name      string
+		results   []Result
+		templates []Template
+	}{
+		{
+			name:    "type filter",
+			results: []Result{ResultSuccess},
+			templates: []Template{
+				{Name: "datasource", Type: "datasource", Query: "prometheus"},
+				{Name: "loki_datasource", Type: "datasource", Query: "loki"},
+			},
+		},
+		{
+			name:    "not a datasource variable",
+			results: []Result{ResultSuccess},
+			templates: []Template{
+				{Name: "filter", Type: "textbox"},
+			},
+		},
+		{
+			name: "no type filter",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' datasource variable named 'datasource' has no datasource type in its query, so it lists every datasource",
+				},
+			},
+			templates: []Template{
+				{Name: "datasource", Type: "datasource"},
+				{Name: "loki_datasource", Type: "datasource", Query: "loki"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{List: tc.templates},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
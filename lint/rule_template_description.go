@@ -0,0 +1,21 @@
+package lint
+
+import "fmt"
+
+// NewTemplateDescriptionRule is not part of the default rule set, as most dashboards don't describe their
+// variables, and simple variables such as job or instance don't need it.
+func NewTemplateDescriptionRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-description-rule",
+		description: "Checks that query template variables have a description.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+			for _, template := range d.GetTemplateByType("query") {
+				if template.Description == "" {
+					r.AddWarning(d, fmt.Sprintf("query variable named '%s' has no description", template.Name))
+				}
+			}
+			return r
+		},
+	}
+}
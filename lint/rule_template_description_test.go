@@ -0,0 +1,68 @@
+package lint
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateDescriptionRule(t *testing.T) {
+	linter := NewTemplateDescriptionRule()
+
+	for _, tc := range []struct {
+		name      string
+		results   []Result
+		templates []Template
+	}{
+		{
+			name:    "described",
+			results: []Result{ResultSuccess},
+			templates: []Template{
+				{Name: "job", Type: "query", Description: "The job to show metrics for"},
+			},
+		},
+		{
+			name:    "not a query variable",
+			results: []Result{ResultSuccess},
+			templates: []Template{
+				{Name: "interval", Type: "interval"},
+				{Name: "env", Type: "custom"},
+			},
+		},
+		{
+			name: "undescribed",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' query variable named 'job' has no description",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' query variable named 'instance' has no description",
+				},
+			},
+			templates: []Template{
+				{Name: "job", Type: "query"},
+				{Name: "cluster", Type: "query", Description: "The cluster to show metrics for"},
+				{Name: "instance", Type: "query"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{List: tc.templates},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
+
+func TestTemplateDescriptionUnmarshal(t *testing.T) {
+	var template Template
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "job", "type": "query", "query": "label_values(job)", "description": "The job"}`), &template))
+	require.Equal(t, "The job", template.Description)
+}
@@ -0,0 +1,39 @@
+package lint
+
+import "fmt"
+
+// minDocumentedVariables is the number of visible variables from which a dashboard is expected to explain them.
+const minDocumentedVariables = 3
+
+// NewVariableDocumentationRule is not part of the default rule set, as it is advisory: many variables, such
+// as job or instance, are self-explanatory.
+func NewVariableDocumentationRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-documentation-rule",
+		description: "Checks that dashboards with many variables explain them.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			visible := 0
+			described := true
+			for _, template := range d.Templating.List {
+				if template.Hide == templateHideVariable {
+					continue
+				}
+				visible++
+				described = described && template.Description != ""
+			}
+			if visible < minDocumentedVariables || described {
+				return r
+			}
+			for _, p := range d.GetPanels() {
+				if p.Type == panelTypeText {
+					return r
+				}
+			}
+
+			r.AddWarning(d, fmt.Sprintf("has %d visible variables, but neither a text panel nor variable descriptions explaining them", visible))
+			return r
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package lint
+
+import "testing"
+
+func TestVariableDocumentationRule(t *testing.T) {
+	linter := NewVariableDocumentationRule()
+
+	templates := []Template{
+		{Name: "datasource", Type: "datasource"},
+		{Name: "cluster", Type: "query"},
+		{Name: "job", Type: "query"},
+		{Name: "instance", Type: "query"},
+		{Name: "prefix", Type: "constant", Hide: templateHideVariable},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		result    Result
+		templates []Template
+		panels    []Panel
+	}{
+		{
+			name:   "few variables",
+			result: ResultSuccess,
+			templates: []Template{
+				{Name: "datasource", Type: "datasource"},
+				{Name: "job", Type: "query"},
+				{Name: "prefix", Type: "constant", Hide: templateHideVariable},
+				{Name: "suffix", Type: "constant", Hide: templateHideVariable},
+			},
+		},
+		{
+			name:      "text panel",
+			result:    ResultSuccess,
+			templates: templates,
+			panels: []Panel{
+				{Type: "row", Title: "About", Panels: []Panel{{Type: "text", Title: "Variables"}}},
+			},
+		},
+		{
+			name:   "described",
+			result: ResultSuccess,
+			templates: []Template{
+				{Name: "datasource", Type: "datasource", Description: "The Prometheus datasource"},
+				{Name: "cluster", Type: "query", Description: "The cluster to show"},
+				{Name: "job", Type: "query", Description: "The job to show"},
+			},
+		},
+		{
+			name: "undocumented",
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has 4 visible variables, but neither a text panel nor variable descriptions explaining them",
+			},
+			templates: templates,
+			panels:    []Panel{{Type: "timeseries", Title: "bar"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{List: tc.templates},
+				Panels: tc.panels,
+			}
+			testRule(t, linter, d, tc.result)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+)
+
+func NewIntervalVariableRule() *DashboardRuleFunc {
+	return NewIntervalVariableRuleWithMinimum(3)
+}
+
+// NewIntervalVariableRuleWithMinimum is like NewIntervalVariableRule, but allows the minimum number of
+// options an interval variable must offer to be configured.
+func NewIntervalVariableRuleWithMinimum(minimum int) *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-interval-rule",
+		description: "Checks that interval template variables offer several options, including auto.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			for _, template := range d.GetTemplateByType("interval") {
+				if len(template.Options) < minimum {
+					r.AddWarning(d, fmt.Sprintf("interval variable named '%s' has %d options, should have at least %d", template.Name, len(template.Options), minimum))
+				}
+
+				hasAuto := false
+				for _, option := range template.Options {
+					value, err := option.Get()
+					if err != nil {
+						r.AddError(d, fmt.Sprintf("interval variable named '%s' has invalid option: %v", template.Name, err))
+						continue
+					}
+					if value.Text == "auto" || strings.HasPrefix(value.Value, "$__auto_interval") {
+						hasAuto = true
+					}
+				}
+				if !hasAuto {
+					r.AddWarning(d, fmt.Sprintf("interval variable named '%s' has no auto option", template.Name))
+				}
+			}
+
+			return r
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package lint
+
+import "testing"
+
+func TestIntervalVariableRule(t *testing.T) {
+	linter := NewIntervalVariableRule()
+
+	auto := RawTemplateValue{"text": "auto", "value": "$__auto_interval_interval"}
+	options := func(values ...string) []RawTemplateValue {
+		out := []RawTemplateValue{}
+		for _, v := range values {
+			out = append(out, RawTemplateValue{"text": v, "value": v})
+		}
+		return out
+	}
+
+	for _, tc := range []struct {
+		name    string
+		results []Result
+		options []RawTemplateValue
+	}{
+		{
+			name:    "auto with steps",
+			results: []Result{ResultSuccess},
+			options: append([]RawTemplateValue{auto}, options("1m", "5m")...),
+		},
+		{
+			name: "too few options",
+			results: []Result{{
+				Severity: Warning,
+				Message:  "Dashboard 'test' interval variable named 'interval' has 2 options, should have at least 3",
+			}},
+			options: append([]RawTemplateValue{auto}, options("1m")...),
+		},
+		{
+			name: "no auto option",
+			results: []Result{{
+				Severity: Warning,
+				Message:  "Dashboard 'test' interval variable named 'interval' has no auto option",
+			}},
+			options: options("1m", "5m", "10m"),
+		},
+		{
+			name: "single option",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' interval variable named 'interval' has 1 options, should have at least 3",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' interval variable named 'interval' has no auto option",
+				},
+			},
+			options: options("1m"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{
+						{
+							Name:    "interval",
+							Type:    "interval",
+							Options: tc.options,
+						},
+					},
+				},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+
+	t.Run("configurable minimum", func(t *testing.T) {
+		d := Dashboard{
+			Title: "test",
+			Templating: struct {
+				List []Template `json:"list"`
+			}{
+				List: []Template{
+					{
+						Name:    "interval",
+						Type:    "interval",
+						Options: []RawTemplateValue{auto},
+					},
+				},
+			},
+		}
+		testRule(t, NewIntervalVariableRuleWithMinimum(1), d, ResultSuccess)
+	})
+}
@@ -0,0 +1,58 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// labelMatcherRegexp matches PromQL and LogQL label matchers, capturing the operator and the quoted value.
+var labelMatcherRegexp = regexp.MustCompile(`[a-zA-Z_][a-zA-Z0-9_]*\s*(=~|!~|!=|=)\s*"((?:[^"\\]|\\.)*)"`)
+
+func NewMultiRegexConsistencyRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-multi-regex-rule",
+		description: "Checks that multi-value variables are matched with regex operators, and single-value variables are not.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			templates := map[string]Template{}
+			for _, t := range d.Templating.List {
+				templates[t.Name] = t
+			}
+
+			reported := map[string]struct{}{}
+			for _, p := range d.GetPanels() {
+				for _, t := range p.Targets {
+					for _, match := range labelMatcherRegexp.FindAllStringSubmatch(t.Expr, -1) {
+						op, value := match[1], match[2]
+						regex := op == "=~" || op == "!~"
+						for _, name := range referencedVariables(value) {
+							template, ok := templates[name]
+							if !ok {
+								continue
+							}
+							// The "All" option expands to a regex matching every value, just like multiple values.
+							multi := template.Multi || template.IncludeAll
+
+							var message string
+							switch {
+							case multi && !regex:
+								message = fmt.Sprintf("variable '%s' is multi-value, but is matched with '%s', use '=~' or '!~'", name, op)
+							case !multi && regex:
+								message = fmt.Sprintf("variable '%s' is single-value, but is matched with '%s', use '=' or '!='", name, op)
+							default:
+								continue
+							}
+							if _, ok := reported[message]; ok {
+								continue
+							}
+							reported[message] = struct{}{}
+							r.AddWarning(d, message)
+						}
+					}
+				}
+			}
+			return r
+		},
+	}
+}
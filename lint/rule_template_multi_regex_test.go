@@ -0,0 +1,72 @@
+package lint
+
+import "testing"
+
+func TestMultiRegexConsistencyRule(t *testing.T) {
+	linter := NewMultiRegexConsistencyRule()
+	templates := []Template{
+		{Name: "job", Type: "query", Multi: true},
+		{Name: "instance", Type: "query", IncludeAll: true},
+		{Name: "cluster", Type: "query"},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		results []Result
+		exprs   []string
+	}{
+		{
+			name:    "consistent",
+			results: []Result{ResultSuccess},
+			exprs: []string{
+				`sum(rate(foo_total{job=~"$job", instance!~"${instance}", cluster="$cluster"}[5m]))`,
+				`{job=~"$job"} |= "error"`,
+			},
+		},
+		{
+			name:    "unknown and builtin variables",
+			results: []Result{ResultSuccess},
+			exprs: []string{
+				`foo{env="$env", interval="$__interval"}`,
+			},
+		},
+		{
+			name: "mismatches",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' variable 'job' is multi-value, but is matched with '=', use '=~' or '!~'",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' variable 'cluster' is single-value, but is matched with '=~', use '=' or '!='",
+				},
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' variable 'instance' is multi-value, but is matched with '!=', use '=~' or '!~'",
+				},
+			},
+			exprs: []string{
+				`sum(rate(foo_total{job="$job", cluster=~"$cluster"}[5m]))`,
+				`sum(rate(bar_total{job="$job", instance!="[[instance]]"}[5m]))`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var targets []Target
+			for _, expr := range tc.exprs {
+				targets = append(targets, Target{Expr: expr})
+			}
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: templates,
+				},
+				Panels: []Panel{{Type: "timeseries", Title: "bar", Targets: targets}},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
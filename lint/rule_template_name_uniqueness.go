@@ -0,0 +1,30 @@
+package lint
+
+import "fmt"
+
+func NewTemplateNameUniquenessRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-name-uniqueness-rule",
+		description: "Checks that template variable names are unique.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			// Names are compared case-sensitively, as Grafana resolves $env and $Env to different variables.
+			counts := map[string]int{}
+			var names []string
+			for _, template := range d.Templating.List {
+				if counts[template.Name] == 0 {
+					names = append(names, template.Name)
+				}
+				counts[template.Name]++
+			}
+
+			for _, name := range names {
+				if counts[name] > 1 {
+					r.AddError(d, fmt.Sprintf("has %d template variables named '%s'", counts[name], name))
+				}
+			}
+			return r
+		},
+	}
+}
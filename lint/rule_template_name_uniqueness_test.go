@@ -0,0 +1,76 @@
+package lint
+
+import "testing"
+
+func TestTemplateNameUniquenessRule(t *testing.T) {
+	linter := NewTemplateNameUniquenessRule()
+
+	for _, tc := range []struct {
+		name      string
+		results   []Result
+		templates []Template
+	}{
+		{
+			name:    "no variables",
+			results: []Result{ResultSuccess},
+		},
+		{
+			name:    "unique names",
+			results: []Result{ResultSuccess},
+			templates: []Template{
+				{Name: "env", Type: "custom"},
+				{Name: "job", Type: "query"},
+			},
+		},
+		{
+			name:    "names differing in case",
+			results: []Result{ResultSuccess},
+			templates: []Template{
+				{Name: "env", Type: "custom"},
+				{Name: "Env", Type: "custom"},
+			},
+		},
+		{
+			name: "duplicate name",
+			results: []Result{{
+				Severity: Error,
+				Message:  "Dashboard 'test' has 2 template variables named 'env'",
+			}},
+			templates: []Template{
+				{Name: "env", Type: "custom"},
+				{Name: "job", Type: "query"},
+				{Name: "env", Type: "query"},
+			},
+		},
+		{
+			name: "several duplicate names",
+			results: []Result{
+				{
+					Severity: Error,
+					Message:  "Dashboard 'test' has 3 template variables named 'job'",
+				},
+				{
+					Severity: Error,
+					Message:  "Dashboard 'test' has 2 template variables named 'env'",
+				},
+			},
+			templates: []Template{
+				{Name: "job", Type: "query"},
+				{Name: "env", Type: "custom"},
+				{Name: "job", Type: "query"},
+				{Name: "env", Type: "custom"},
+				{Name: "job", Type: "query"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{List: tc.templates},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var templateQueryFunctionRegexp = regexp.MustCompile(`^\s*([a-z_]+)\s*\((.*)\)\s*$`)
+
+// NewTemplateQueryShapeRule builds a lint rule which checks that Prometheus query variables are wrapped in
+// one of the Grafana templating functions, rather than returning raw series.
+func NewTemplateQueryShapeRule() *DashboardRuleFunc {
+	// https://grafana.com/docs/grafana/latest/datasources/prometheus/template-variables/#use-query-variables
+	return NewTemplateQueryShapeRuleWithFunctions([]string{"label_names", "label_values", "metrics", "query_result"})
+}
+
+// NewTemplateQueryShapeRuleWithFunctions is like NewTemplateQueryShapeRule, but allows the set of recognized
+// templating functions to be configured.
+func NewTemplateQueryShapeRuleWithFunctions(functions []string) *DashboardRuleFunc {
+	allowed := make(map[string]struct{}, len(functions))
+	for _, f := range functions {
+		allowed[f] = struct{}{}
+	}
+
+	return &DashboardRuleFunc{
+		name:        "template-query-shape-rule",
+		description: "Checks that Prometheus query variables use a templating function such as label_values or query_result.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			template := getTemplateDatasource(d)
+			if template == nil || template.Query != Prometheus {
+				return r
+			}
+			for _, template := range d.Templating.List {
+				if template.Type != targetTypeQuery || template.Query == "" {
+					continue
+				}
+				tokens := templateQueryFunctionRegexp.FindStringSubmatch(template.Query)
+				if tokens != nil {
+					if _, ok := allowed[tokens[1]]; ok {
+						continue
+					}
+				}
+				r.AddWarning(d, fmt.Sprintf("template '%s' query '%s' is not wrapped in a recognized templating function", template.Name, template.Query))
+			}
+
+			return r
+		},
+	}
+}
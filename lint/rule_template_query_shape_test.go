@@ -0,0 +1,100 @@
+package lint
+
+import "testing"
+
+func TestTemplateQueryShapeRule(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		linter   Rule
+		result   Result
+		template Template
+	}{
+		{
+			name:   "label_values",
+			linter: NewTemplateQueryShapeRule(),
+			result: ResultSuccess,
+			template: Template{
+				Name:  "job",
+				Type:  "query",
+				Query: `label_values(up, job)`,
+			},
+		},
+		{
+			name:   "query_result",
+			linter: NewTemplateQueryShapeRule(),
+			result: ResultSuccess,
+			template: Template{
+				Name:  "job",
+				Type:  "query",
+				Query: `query_result(sum by (job) (up))`,
+			},
+		},
+		{
+			name:   "bare metric",
+			linter: NewTemplateQueryShapeRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' template 'job' query 'up{job=\"foo\"}' is not wrapped in a recognized templating function",
+			},
+			template: Template{
+				Name:  "job",
+				Type:  "query",
+				Query: `up{job="foo"}`,
+			},
+		},
+		{
+			name:   "aggregation",
+			linter: NewTemplateQueryShapeRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' template 'job' query 'sum by (job) (up)' is not wrapped in a recognized templating function",
+			},
+			template: Template{
+				Name:  "job",
+				Type:  "query",
+				Query: `sum by (job) (up)`,
+			},
+		},
+		{
+			name:   "configured functions",
+			linter: NewTemplateQueryShapeRuleWithFunctions([]string{"label_values"}),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' template 'job' query 'query_result(up)' is not wrapped in a recognized templating function",
+			},
+			template: Template{
+				Name:  "job",
+				Type:  "query",
+				Query: `query_result(up)`,
+			},
+		},
+		{
+			name:   "non-query variable",
+			linter: NewTemplateQueryShapeRule(),
+			result: ResultSuccess,
+			template: Template{
+				Name:  "limit",
+				Type:  "custom",
+				Query: `10,20`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: []Template{
+						{
+							Type:  "datasource",
+							Query: "prometheus",
+						},
+						tc.template,
+					},
+				},
+			}
+			testRule(t, tc.linter, d, tc.result)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package lint
+
+import "fmt"
+
+// NewRequireDatasourceVariableRule requires dashboards with queries to declare a datasource variable, with
+// any name.
+func NewRequireDatasourceVariableRule() *DashboardRuleFunc {
+	return NewRequireDatasourceVariableRuleWithName("")
+}
+
+// NewRequireDatasourceVariableRuleWithName is like NewRequireDatasourceVariableRule, but requires one of the
+// datasource variables to have the given name, e.g. "datasource" or "DS_PROMETHEUS". An empty name accepts
+// any datasource variable.
+func NewRequireDatasourceVariableRuleWithName(name string) *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-require-datasource-rule",
+		description: "Checks that dashboards with queries declare a datasource variable.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			hasQueries := false
+			for _, p := range d.GetPanels() {
+				if panelHasQueries(p) && len(p.Targets) > 0 {
+					hasQueries = true
+					break
+				}
+			}
+			if !hasQueries {
+				return r
+			}
+
+			for _, template := range d.GetTemplateByType("datasource") {
+				if name == "" || template.Name == name {
+					return r
+				}
+			}
+
+			if name == "" {
+				r.AddWarning(d, "has panels with queries, but no datasource variable was found")
+			} else {
+				r.AddWarning(d, fmt.Sprintf("has panels with queries, but no datasource variable named '%s' was found", name))
+			}
+			return r
+		},
+	}
+}
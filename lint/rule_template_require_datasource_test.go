@@ -0,0 +1,104 @@
+package lint
+
+import "testing"
+
+func TestRequireDatasourceVariableRule(t *testing.T) {
+	queryPanel := Panel{Type: "timeseries", Title: "bar", Targets: []Target{{Expr: "up"}}}
+
+	for _, tc := range []struct {
+		name      string
+		linter    Rule
+		result    Result
+		templates []Template
+		panels    []Panel
+	}{
+		{
+			name:   "no panels",
+			linter: NewRequireDatasourceVariableRule(),
+			result: ResultSuccess,
+		},
+		{
+			name:   "text panels only",
+			linter: NewRequireDatasourceVariableRule(),
+			result: ResultSuccess,
+			panels: []Panel{{Type: "text", Title: "notes"}},
+		},
+		{
+			name:      "datasource variable",
+			linter:    NewRequireDatasourceVariableRule(),
+			result:    ResultSuccess,
+			templates: []Template{{Name: "datasource", Type: "datasource", Query: "prometheus"}},
+			panels:    []Panel{queryPanel},
+		},
+		{
+			name:      "type specific datasource variable",
+			linter:    NewRequireDatasourceVariableRule(),
+			result:    ResultSuccess,
+			templates: []Template{{Name: "prometheus_datasource", Type: "datasource", Query: "prometheus"}},
+			panels:    []Panel{queryPanel},
+		},
+		{
+			name:   "several datasource variables",
+			linter: NewRequireDatasourceVariableRule(),
+			result: ResultSuccess,
+			templates: []Template{
+				{Name: "prometheus_datasource", Type: "datasource", Query: "prometheus"},
+				{Name: "loki_datasource", Type: "datasource", Query: "loki"},
+			},
+			panels: []Panel{queryPanel},
+		},
+		{
+			name:   "no datasource variable",
+			linter: NewRequireDatasourceVariableRule(),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has panels with queries, but no datasource variable was found",
+			},
+			templates: []Template{{Name: "job", Type: "query"}},
+			panels:    []Panel{queryPanel},
+		},
+		{
+			name:   "no datasource variable with required name",
+			linter: NewRequireDatasourceVariableRuleWithName("DS_PROMETHEUS"),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has panels with queries, but no datasource variable named 'DS_PROMETHEUS' was found",
+			},
+			templates: []Template{{Name: "job", Type: "query"}},
+			panels:    []Panel{queryPanel},
+		},
+		{
+			name:      "named datasource variable",
+			linter:    NewRequireDatasourceVariableRuleWithName("DS_PROMETHEUS"),
+			result:    ResultSuccess,
+			templates: []Template{{Name: "DS_PROMETHEUS", Type: "datasource", Query: "prometheus"}},
+			panels:    []Panel{queryPanel},
+		},
+		{
+			name:   "differently named datasource variable",
+			linter: NewRequireDatasourceVariableRuleWithName("DS_PROMETHEUS"),
+			result: Result{
+				Severity: Warning,
+				Message:  "Dashboard 'test' has panels with queries, but no datasource variable named 'DS_PROMETHEUS' was found",
+			},
+			templates: []Template{
+				{Name: "prometheus_datasource", Type: "datasource", Query: "prometheus"},
+				{Name: "loki_datasource", Type: "datasource", Query: "loki"},
+			},
+			panels: []Panel{queryPanel},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{
+					List: tc.templates,
+				},
+				Panels: tc.panels,
+			}
+			testRule(t, tc.linter, d, tc.result)
+		})
+	}
+}
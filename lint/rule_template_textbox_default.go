@@ -0,0 +1,50 @@
+package lint
+
+import "fmt"
+
+func NewTextboxVariableRule() *DashboardRuleFunc {
+	return &DashboardRuleFunc{
+		name:        "template-textbox-default-rule",
+		description: "Checks that textbox variables used in exact label matchers have a default value.",
+		fn: func(d Dashboard) DashboardRuleResults {
+			r := DashboardRuleResults{}
+
+			empty := map[string]struct{}{}
+			for _, template := range d.GetTemplateByType("textbox") {
+				current, err := template.Current.Get()
+				if err != nil {
+					// An invalid current value is reported by other rules.
+					continue
+				}
+				if current.Value == "" {
+					empty[template.Name] = struct{}{}
+				}
+			}
+			if len(empty) == 0 {
+				return r
+			}
+
+			reported := map[string]struct{}{}
+			for _, p := range d.GetPanels() {
+				for _, t := range p.Targets {
+					for _, match := range labelMatcherRegexp.FindAllStringSubmatch(t.Expr, -1) {
+						if match[1] != "=" {
+							continue
+						}
+						for _, name := range referencedVariables(match[2]) {
+							if _, ok := empty[name]; !ok {
+								continue
+							}
+							if _, ok := reported[name]; ok {
+								continue
+							}
+							reported[name] = struct{}{}
+							r.AddWarning(d, fmt.Sprintf("textbox variable named '%s' has an empty value, but is matched with '=', which only matches series without the label", name))
+						}
+					}
+				}
+			}
+			return r
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package lint
+
+import "testing"
+
+func TestTextboxVariableRule(t *testing.T) {
+	linter := NewTextboxVariableRule()
+
+	textbox := func(name, value string) Template {
+		return Template{Name: name, Type: "textbox", Current: RawTemplateValue{"text": value, "value": value}}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		results   []Result
+		templates []Template
+		exprs     []string
+	}{
+		{
+			name:      "default value",
+			results:   []Result{ResultSuccess},
+			templates: []Template{textbox("path", "/api")},
+			exprs:     []string{`sum(rate(http_requests_total{path="$path"}[5m]))`},
+		},
+		{
+			name:      "regex matcher",
+			results:   []Result{ResultSuccess},
+			templates: []Template{textbox("path", "")},
+			exprs:     []string{`sum(rate(http_requests_total{path=~".*$path.*"}[5m]))`},
+		},
+		{
+			name:      "not a textbox variable",
+			results:   []Result{ResultSuccess},
+			templates: []Template{{Name: "path", Type: "custom", Current: RawTemplateValue{"value": ""}}},
+			exprs:     []string{`sum(rate(http_requests_total{path="$path"}[5m]))`},
+		},
+		{
+			name: "empty value",
+			results: []Result{
+				{
+					Severity: Warning,
+					Message:  "Dashboard 'test' textbox variable named 'path' has an empty value, but is matched with '=', which only matches series without the label",
+				},
+			},
+			templates: []Template{textbox("path", ""), textbox("method", "GET")},
+			exprs: []string{
+				`sum(rate(http_requests_total{path="${path}", method="$method"}[5m]))`,
+				`sum(rate(http_request_errors_total{path="$path"}[5m]))`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var targets []Target
+			for _, expr := range tc.exprs {
+				targets = append(targets, Target{Expr: expr})
+			}
+			d := Dashboard{
+				Title: "test",
+				Templating: struct {
+					List []Template `json:"list"`
+				}{List: tc.templates},
+				Panels: []Panel{{Type: "timeseries", Title: "bar", Targets: targets}},
+			}
+			testMultiResultRule(t, linter, d, tc.results)
+		})
+	}
+}
@@ -1,5 +1,14 @@
 package lint
 
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+)
+
 type Rule interface {
 	Description() string
 	Name() string
@@ -18,7 +27,36 @@ func NewDashboardRuleFunc(name, description string, fn func(Dashboard) Dashboard
 func (f DashboardRuleFunc) Name() string        { return f.name }
 func (f DashboardRuleFunc) Description() string { return f.description }
 func (f DashboardRuleFunc) Lint(d Dashboard, s *ResultSet) {
-	dashboardResults := f.fn(d).Results
+	lintDashboard(f, d, f.fn(d).Results, s)
+}
+
+// ContextRule is implemented by rules which need to know about the other dashboards being linted, e.g. to
+// resolve links between them. The context maps the uid of each dashboard in the LintSet to the dashboard.
+// When linted without a LintSet, the context is nil.
+type ContextRule interface {
+	Rule
+	LintWithContext(Dashboard, map[string]*Dashboard, *ResultSet)
+}
+
+type DashboardContextRuleFunc struct {
+	name, description string
+	fn                func(Dashboard, map[string]*Dashboard) DashboardRuleResults
+}
+
+func NewDashboardContextRuleFunc(name, description string, fn func(Dashboard, map[string]*Dashboard) DashboardRuleResults) Rule {
+	return &DashboardContextRuleFunc{name, description, fn}
+}
+
+func (f DashboardContextRuleFunc) Name() string        { return f.name }
+func (f DashboardContextRuleFunc) Description() string { return f.description }
+func (f DashboardContextRuleFunc) Lint(d Dashboard, s *ResultSet) {
+	f.LintWithContext(d, nil, s)
+}
+func (f DashboardContextRuleFunc) LintWithContext(d Dashboard, dashboards map[string]*Dashboard, s *ResultSet) {
+	lintDashboard(f, d, f.fn(d, dashboards).Results, s)
+}
+
+func lintDashboard(rule Rule, d Dashboard, dashboardResults []DashboardResult, s *ResultSet) {
 	if len(dashboardResults) == 0 {
 		dashboardResults = []DashboardResult{{
 			Result: ResultSuccess,
@@ -44,7 +82,7 @@ func (f DashboardRuleFunc) Lint(d Dashboard, s *ResultSet) {
 
 	s.AddResult(ResultContext{
 		Result:    RuleResults{rr},
-		Rule:      f,
+		Rule:      rule,
 		Dashboard: &d,
 	})
 }
@@ -61,12 +99,34 @@ func NewPanelRuleFunc(name, description string, fn func(Dashboard, Panel) PanelR
 func (f PanelRuleFunc) Name() string        { return f.name }
 func (f PanelRuleFunc) Description() string { return f.description }
 func (f PanelRuleFunc) Lint(d Dashboard, s *ResultSet) {
+	lintPanels(f, d, func(p Panel) PanelRuleResults { return f.fn(d, p) }, s)
+}
+
+type PanelContextRuleFunc struct {
+	name, description string
+	fn                func(Dashboard, Panel, map[string]*Dashboard) PanelRuleResults
+}
+
+func NewPanelContextRuleFunc(name, description string, fn func(Dashboard, Panel, map[string]*Dashboard) PanelRuleResults) Rule {
+	return &PanelContextRuleFunc{name, description, fn}
+}
+
+func (f PanelContextRuleFunc) Name() string        { return f.name }
+func (f PanelContextRuleFunc) Description() string { return f.description }
+func (f PanelContextRuleFunc) Lint(d Dashboard, s *ResultSet) {
+	f.LintWithContext(d, nil, s)
+}
+func (f PanelContextRuleFunc) LintWithContext(d Dashboard, dashboards map[string]*Dashboard, s *ResultSet) {
+	lintPanels(f, d, func(p Panel) PanelRuleResults { return f.fn(d, p, dashboards) }, s)
+}
+
+func lintPanels(rule Rule, d Dashboard, fn func(Panel) PanelRuleResults, s *ResultSet) {
 	for pi, p := range d.GetPanels() {
 		p := p   // capture loop variable
 		pi := pi // capture loop variable
 		var rr []FixableResult
 
-		panelResults := f.fn(d, p).Results
+		panelResults := fn(p).Results
 		if len(panelResults) == 0 {
 			panelResults = []PanelResult{{
 				Result: ResultSuccess,
@@ -89,7 +149,7 @@ func (f PanelRuleFunc) Lint(d Dashboard, s *ResultSet) {
 
 		s.AddResult(ResultContext{
 			Result:    RuleResults{rr},
-			Rule:      f,
+			Rule:      rule,
 			Dashboard: &d,
 			Panel:     &p,
 		})
@@ -174,26 +234,127 @@ func NewRuleSet() RuleSet {
 	return RuleSet{
 		rules: []Rule{
 			NewTemplateDatasourceRule(),
+			NewTemplateNameUniquenessRule(),
+			NewConstantVariableRule(),
+			NewTextboxVariableRule(),
+			NewDatasourceVariableDefaultRule(),
+			NewDatasourceVarTypeRule(),
+			NewRequireDatasourceVariableRule(),
+			NewDashboardDatasourceConsistencyRule(),
+			NewGraphTooltipRule(),
+			NewTimezoneRule(),
+			NewCrossPanelUnitRule(),
+			NewDashboardVersionRule(),
+			NewExportCleanlinessRule(),
+			NewFiscalYearRule(),
+			NewDashboardIDRule(),
+			NewEmptyRowRule(),
+			NewMixedLayoutRule(),
+			NewLegendPlacementConsistencyRule(),
+			NewDashboardLinkVarsRule(),
+			NewRepeatGridPosRule(),
+			NewDashboardTargetBudgetRule(),
 			NewTemplateJobRule(),
 			NewTemplateInstanceRule(),
 			NewTemplateLabelPromQLRule(),
+			NewTemplateQueryShapeRule(),
+			NewIntervalVariableRule(),
+			NewMultiRegexConsistencyRule(),
+			NewAllValueUsageRule(),
 			NewTemplateOnTimeRangeReloadRule(),
+			NewAnnotationConfigRule(),
+			NewAnnotationQueryRule(),
 			NewPanelDatasourceRule(),
+			NewDatasourceByNameRule(),
+			NewAngularPanelRule(),
+			NewImportPlaceholderRule(),
 			NewPanelTitleDescriptionRule(),
+			NewRowTitleRule(),
+			NewDescriptionLinkRule(),
+			NewPanelDashboardLinkRule(),
+			NewPanelTitleVariableRule(),
+			NewPanelTitleLengthRule(),
+			NewRedundantPanelTitleRule(),
+			NewDescriptionVariableRule(),
 			NewPanelUnitsRule(),
+			NewPanelOptionsRule(),
+			NewCurrencyPrecisionRule(),
+			NewPercentAxisRule(),
+			NewFillOpacityRule(),
+			NewMultiAxisRule(),
+			NewTooltipModeRule(),
+			NewStackingRule(),
+			NewStatDisplayRule(),
+			NewStatGraphModeRule(),
+			NewReduceLimitRule(),
+			NewMaxDataPointsIntervalRule(),
+			NewThresholdOrderRule(),
+			NewLogsPanelRule(),
+			NewGaugeSingleSeriesRule(),
+			NewBarGaugeMinMaxRule(),
+			NewMinMaxOverrideRule(),
+			NewOverrideTargetRule(),
+			NewUnusedOverrideRule(),
+			NewTableColumnRule(),
+			NewTableFooterRule(),
+			NewHeatmapConfigRule(),
+			NewRedundantUnitRule(),
+			NewFixedColorRule(),
 			NewPanelNoTargetsRule(),
+			NewHiddenTargetRule(),
+			NewGridPosBoundsRule(),
+			NewGridPosOverflowRule(),
+			NewDuplicateTargetRule(),
 			NewTargetLogQLRule(),
 			NewTargetLogQLAutoRule(),
+			NewLogQLFilterRule(),
 			NewTargetPromQLRule(),
+			NewDatasourceMacroRule(),
+			NewLegendTokenSyntaxRule(),
+			NewBlankLegendRule(),
+			NewExprLengthRule(),
+			NewNameRegexRule(),
+			NewGaugeCounterSemanticsRule(),
 			NewTargetRateIntervalRule(),
+			NewRateRangeRule(),
 			NewTargetJobRule(),
 			NewTargetInstanceRule(),
 			NewTargetCounterAggRule(),
+			NewVectorMatchingRule(),
+			NewSetOperatorRule(),
+			NewScalarVectorRule(),
+			NewTopkRule(),
+			NewCountValuesRule(),
+			NewLabelReplaceRule(),
+			NewHistogramLeRule(),
+			NewSumWithoutLeRule(),
+			NewBareRangeVectorRule(),
+			NewQuantileArgRule(),
+			NewDerivCounterRule(),
+			NewAbsentRule(),
+			NewEmptyOnRule(),
+			NewStatReduceRule(),
 			NewUneditableRule(),
 		},
 	}
 }
 
+// NewOptInRules returns the rules which are not part of the default rule set, as they are only useful for
+// some dashboards. They can be enabled by name with RuleSet.Enable.
+func NewOptInRules() []Rule {
+	return []Rule{
+		NewComparisonBoolRule(),
+		NewTemplateDescriptionRule(),
+		NewRefIdConventionRule(),
+		NewSpanNullsRule(),
+		NewPluginVersionRule(),
+		NewVariableDocumentationRule(),
+		NewDashboardEditableRule(),
+		NewProvisioningCleanlinessRule(),
+		NewOrientationRule(),
+	}
+}
+
 func (s *RuleSet) Rules() []Rule {
 	return s.rules
 }
@@ -202,6 +363,32 @@ func (s *RuleSet) Add(r Rule) {
 	s.rules = append(s.rules, r)
 }
 
+// Enable adds the opt-in rules with the given names, see NewOptInRules, to the rule set. Rules which are
+// already part of the rule set are not added again.
+func (s *RuleSet) Enable(names ...string) error {
+	optIn := map[string]Rule{}
+	for _, r := range NewOptInRules() {
+		optIn[r.Name()] = r
+	}
+	enabled := map[string]bool{}
+	for _, r := range s.rules {
+		enabled[r.Name()] = true
+	}
+
+	for _, name := range names {
+		r, ok := optIn[name]
+		if !ok {
+			return fmt.Errorf("%s is not an opt-in rule", name)
+		}
+		if enabled[name] {
+			continue
+		}
+		s.Add(r)
+		enabled[name] = true
+	}
+	return nil
+}
+
 func (s *RuleSet) Lint(dashboards []Dashboard) (*ResultSet, error) {
 	resSet := &ResultSet{}
 	for _, d := range dashboards {
@@ -211,3 +398,127 @@ func (s *RuleSet) Lint(dashboards []Dashboard) (*ResultSet, error) {
 	}
 	return resSet, nil
 }
+
+// LintObserver is notified as LintDir and LintFiles lint each dashboard, e.g. to render progress when
+// linting many dashboards. Dashboards are linted concurrently, so its methods may be called concurrently
+// from several goroutines, and must be safe for concurrent use.
+type LintObserver interface {
+	// OnDashboardStart is called before the dashboard at path is linted. All dashboards are read before the
+	// first one is linted, so that rules can resolve references between them.
+	OnDashboardStart(path string)
+	// OnDashboardDone is called after the dashboard at path was linted, with the number of results other
+	// than successes reported for it. It is called from the same goroutine as OnDashboardStart for path.
+	OnDashboardDone(path string, results int)
+}
+
+// LintFiles lints the dashboards in the given files together as a LintSet. Files matching the .lintignore
+// file in their directory or any parent directory are skipped, and counted in ResultSet.Skipped. If observer
+// is not nil, it is notified about each dashboard which is not skipped.
+func (s *RuleSet) LintFiles(paths []string, observer LintObserver) (*ResultSet, error) {
+	ignore := newIgnoreFiles()
+	var files []string
+	skipped := 0
+	for _, path := range paths {
+		ignored, err := ignore.Match(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s: %v", IgnoreFileName, err)
+		}
+		if ignored {
+			skipped++
+			continue
+		}
+		files = append(files, path)
+	}
+	return s.lintFiles(files, skipped, observer)
+}
+
+// LintDir lints the dashboards in all .json files in dir and its subdirectories together as a LintSet.
+// Files matching the .lintignore file in their directory or any parent directory, including those above
+// dir, are skipped, and counted in ResultSet.Skipped. If observer is not nil, it is notified about each
+// dashboard which is not skipped.
+func (s *RuleSet) LintDir(dir string, observer LintObserver) (*ResultSet, error) {
+	ignore := newIgnoreFiles()
+	var files []string
+	skipped := 0
+	err := filepath.WalkDir(dir, func(path string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if e.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+		ignored, err := ignore.Match(path)
+		if err != nil {
+			return fmt.Errorf("failed to load %s: %v", IgnoreFileName, err)
+		}
+		if ignored {
+			skipped++
+			return nil
+		}
+		files = append(files, path)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %v", dir, err)
+	}
+	return s.lintFiles(files, skipped, observer)
+}
+
+func (s *RuleSet) lintFiles(files []string, skipped int, observer LintObserver) (*ResultSet, error) {
+	dashboards := make([]Dashboard, 0, len(files))
+	for _, filename := range files {
+		buf, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %s: %v", filename, err)
+		}
+		dashboard, err := NewDashboard(buf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse dashboard %s: %v", filename, err)
+		}
+		dashboards = append(dashboards, dashboard)
+	}
+
+	ls := NewLintSet(dashboards...)
+	// Each dashboard is linted into its own ResultSet, so that results are reported in file order.
+	sets := make([]*ResultSet, len(files))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(runtime.GOMAXPROCS(0), len(files)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if observer != nil {
+					observer.OnDashboardStart(files[i])
+				}
+				sets[i] = &ResultSet{}
+				ls.lintDashboard(ls.dashboards[i], s, sets[i])
+				if observer != nil {
+					observer.OnDashboardDone(files[i], countProblems(sets[i].results))
+				}
+			}
+		}()
+	}
+	for i := range files {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	resSet := MergeResults(sets...)
+	resSet.skipped = skipped
+	return resSet, nil
+}
+
+// countProblems returns the number of results other than successes in results.
+func countProblems(results []ResultContext) int {
+	n := 0
+	for _, rc := range results {
+		for _, r := range rc.Result.Results {
+			if r.Severity != Success {
+				n++
+			}
+		}
+	}
+	return n
+}
@@ -1,7 +1,10 @@
 package lint_test
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/grafana/dashboard-linter/lint"
@@ -68,6 +71,31 @@ func TestCustomRules(t *testing.T) {
 	}
 }
 
+func TestEnableOptInRules(t *testing.T) {
+	t.Run("Enables opt-in rules once", func(t *testing.T) {
+		rules := lint.NewRuleSet()
+		before := len(rules.Rules())
+		assert.NoError(t, rules.Enable("panel-span-nulls-rule", "target-comparison-bool-rule", "panel-span-nulls-rule"))
+		assert.Len(t, rules.Rules(), before+2)
+		assert.Equal(t, "target-comparison-bool-rule", rules.Rules()[before+1].Name())
+	})
+
+	t.Run("Rejects unknown and default rules", func(t *testing.T) {
+		rules := lint.NewRuleSet()
+		assert.Error(t, rules.Enable("no-such-rule"))
+		assert.Error(t, rules.Enable("template-job-rule"))
+	})
+
+	t.Run("Reads enabled rules from the configuration file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), ".lint")
+		assert.NoError(t, os.WriteFile(path, []byte("enable:\n  - panel-span-nulls-rule\n"), 0600))
+
+		config := lint.NewConfigurationFile()
+		assert.NoError(t, config.Load(path))
+		assert.Equal(t, []string{"panel-span-nulls-rule"}, config.Enable)
+	})
+}
+
 func TestFixableRules(t *testing.T) {
 	sampleDashboard, err := os.ReadFile("testdata/dashboard.json")
 	assert.NoError(t, err)
@@ -99,3 +127,171 @@ func TestFixableRules(t *testing.T) {
 
 	assert.Equal(t, "Sample dashboard fixed-once fixed-twice", dashboard.Title)
 }
+
+func TestLintDirIgnoreFile(t *testing.T) {
+	sampleDashboard, err := os.ReadFile("testdata/dashboard.json")
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "vendor"), 0700))
+	for _, name := range []string{"a.json", "b.json", "vendor/c.json", "vendor/d.json"} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), sampleDashboard, 0600))
+	}
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, lint.IgnoreFileName), []byte("vendor/\nb.json\n"), 0600))
+
+	rule := lint.NewDashboardRuleFunc(
+		"test-dashboard-rule", "Test dashboard rule",
+		func(lint.Dashboard) lint.DashboardRuleResults {
+			return lint.DashboardRuleResults{Results: []lint.DashboardResult{{
+				Result: lint.Result{Severity: lint.Error, Message: "Error found"},
+			}}}
+		},
+	)
+	rules := lint.RuleSet{}
+	rules.Add(rule)
+
+	t.Run("LintDir", func(t *testing.T) {
+		results, err := rules.LintDir(dir, nil)
+		assert.NoError(t, err)
+		assert.Len(t, results.ByRule()[rule.Name()], 1)
+		assert.Equal(t, 3, results.Skipped())
+	})
+
+	t.Run("LintFiles", func(t *testing.T) {
+		results, err := rules.LintFiles([]string{
+			filepath.Join(dir, "a.json"),
+			filepath.Join(dir, "b.json"),
+			filepath.Join(dir, "vendor", "c.json"),
+		}, nil)
+		assert.NoError(t, err)
+		assert.Len(t, results.ByRule()[rule.Name()], 1)
+		assert.Equal(t, 2, results.Skipped())
+	})
+}
+
+func TestLintDirNestedIgnoreFile(t *testing.T) {
+	sampleDashboard, err := os.ReadFile("testdata/dashboard.json")
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "team", "vendor"), 0700))
+	for _, name := range []string{"a-legacy.json", "team/b.json", "team/c-legacy.json", "team/keep-legacy.json", "team/vendor/d.json"} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), sampleDashboard, 0600))
+	}
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, lint.IgnoreFileName), []byte("*-legacy.json\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "team", lint.IgnoreFileName), []byte("vendor/\n!keep-legacy.json\n"), 0600))
+
+	rule := lint.NewDashboardRuleFunc(
+		"test-dashboard-rule", "Test dashboard rule",
+		func(lint.Dashboard) lint.DashboardRuleResults {
+			return lint.DashboardRuleResults{Results: []lint.DashboardResult{{
+				Result: lint.Result{Severity: lint.Error, Message: "Error found"},
+			}}}
+		},
+	)
+	rules := lint.RuleSet{}
+	rules.Add(rule)
+
+	// team/b.json and team/keep-legacy.json are linted, the rest is ignored by either file.
+	for name, run := range map[string]func() (*lint.ResultSet, error){
+		"LintDir root": func() (*lint.ResultSet, error) {
+			return rules.LintDir(dir, nil)
+		},
+		"LintDir nested": func() (*lint.ResultSet, error) {
+			return rules.LintDir(filepath.Join(dir, "team"), nil)
+		},
+		"LintFiles": func() (*lint.ResultSet, error) {
+			return rules.LintFiles([]string{
+				filepath.Join(dir, "team", "b.json"),
+				filepath.Join(dir, "team", "c-legacy.json"),
+				filepath.Join(dir, "team", "keep-legacy.json"),
+				filepath.Join(dir, "team", "vendor", "d.json"),
+			}, nil)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			results, err := run()
+			assert.NoError(t, err)
+			assert.Len(t, results.ByRule()[rule.Name()], 2)
+		})
+	}
+}
+
+// recordingObserver records the events for each dashboard, as dashboards are linted concurrently.
+type recordingObserver struct {
+	mu     sync.Mutex
+	events map[string][]string
+}
+
+func (o *recordingObserver) record(path, event string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.events == nil {
+		o.events = map[string][]string{}
+	}
+	o.events[filepath.Base(path)] = append(o.events[filepath.Base(path)], event)
+}
+
+func (o *recordingObserver) OnDashboardStart(path string) {
+	o.record(path, "start")
+}
+
+func (o *recordingObserver) OnDashboardDone(path string, results int) {
+	o.record(path, fmt.Sprintf("done %d", results))
+}
+
+func TestLintObserver(t *testing.T) {
+	sampleDashboard, err := os.ReadFile("testdata/dashboard.json")
+	assert.NoError(t, err)
+
+	// Enough dashboards for them to be linted concurrently.
+	dir := t.TempDir()
+	var files []string
+	for i := 0; i < 20; i++ {
+		files = append(files, filepath.Join(dir, fmt.Sprintf("%02d.json", i)))
+	}
+	files = append(files, filepath.Join(dir, "ignored.json"))
+	for _, file := range files {
+		assert.NoError(t, os.WriteFile(file, sampleDashboard, 0600))
+	}
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, lint.IgnoreFileName), []byte("ignored.json\n"), 0600))
+
+	rules := lint.RuleSet{}
+	rules.Add(lint.NewDashboardRuleFunc(
+		"test-dashboard-rule", "Test dashboard rule",
+		func(lint.Dashboard) lint.DashboardRuleResults {
+			return lint.DashboardRuleResults{Results: []lint.DashboardResult{
+				{Result: lint.Result{Severity: lint.Error, Message: "Error found"}},
+				{Result: lint.Result{Severity: lint.Warning, Message: "Warning found"}},
+			}}
+		},
+	))
+	rules.Add(lint.NewDashboardRuleFunc(
+		"test-success-rule", "Test success rule",
+		func(lint.Dashboard) lint.DashboardRuleResults {
+			return lint.DashboardRuleResults{}
+		},
+	))
+
+	// Each dashboard which isn't ignored is started and done once, in that order.
+	expected := map[string][]string{}
+	for _, file := range files[:len(files)-1] {
+		expected[filepath.Base(file)] = []string{"start", "done 2"}
+	}
+
+	t.Run("LintDir", func(t *testing.T) {
+		observer := &recordingObserver{}
+		_, err := rules.LintDir(dir, observer)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, observer.events)
+	})
+
+	t.Run("LintFiles", func(t *testing.T) {
+		observer := &recordingObserver{}
+		results, err := rules.LintFiles(files, observer)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, observer.events)
+		assert.Len(t, results.ByRule()["test-dashboard-rule"], len(expected))
+		assert.Equal(t, 1, results.Skipped())
+	})
+}
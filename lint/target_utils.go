@@ -2,6 +2,7 @@ package lint
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/prometheus/prometheus/model/labels"
 )
@@ -30,3 +31,34 @@ func checkForMatcher(selector []*labels.Matcher, name string, ty labels.MatchTyp
 
 	return result
 }
+
+// targetDatasourceType returns the type of the datasource queried by a target. The target's datasource takes
+// precedence over the panel's, and when neither has a type the query of the dashboard's templated datasource
+// is used, e.g. "prometheus".
+func targetDatasourceType(d Dashboard, p Panel, t Target) string {
+	if ds, err := t.GetDataSource(); err == nil && ds.Type != "" {
+		return ds.Type
+	}
+	if ds, err := p.GetDataSource(); err == nil && ds.Type != "" {
+		return ds.Type
+	}
+	if template := getTemplateDatasource(d); template != nil {
+		return template.Query
+	}
+	return ""
+}
+
+// normalizeExpr returns a canonical form of a target expression, so that expressions which only differ
+// in formatting, such as whitespace or the position of a grouping clause, compare equal. Expressions which
+// are not valid PromQL, e.g. LogQL queries, fall back to having their whitespace collapsed.
+func normalizeExpr(expr string, variables []Template) string {
+	if node, err := parsePromQL(expr, variables); err == nil {
+		return node.String()
+	}
+	return collapseWhitespace(expr)
+}
+
+// collapseWhitespace replaces all runs of whitespace in an expression with a single space.
+func collapseWhitespace(expr string) string {
+	return strings.Join(strings.Fields(expr), " ")
+}
@@ -261,3 +261,54 @@ func expandLogQLVariables(expr string, variables []Template) (string, error) {
 	result := strings.Join(lines, "\n")
 	return result, nil
 }
+
+// referencedVariables returns the names of all variables referenced in s, in any of the
+// $var, ${var}, ${var:format} or [[var]] syntaxes.
+func referencedVariables(s string) []string {
+	var names []string
+	for _, match := range variableRegexp.FindAllStringSubmatch(s, -1) {
+		for _, group := range match[1:] {
+			if group == "" {
+				continue
+			}
+			name, _, _ := strings.Cut(group, ":")
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// isBuiltinVariable returns true for Grafana global variables. Grafana reserves the `__` prefix for
+// its own variables, so anything using it is assumed to be built in.
+func isBuiltinVariable(name string) bool {
+	if _, ok := globalVariables[name]; ok {
+		return true
+	}
+	return strings.HasPrefix(name, "__")
+}
+
+// unknownVariables returns the variables referenced in s which are neither built in nor declared in
+// the dashboard templating list. Numeric references such as "$5" are ignored, as they are far more
+// likely to be a currency amount than a variable.
+func unknownVariables(s string, variables []Template) []string {
+	var unknown []string
+	for _, name := range referencedVariables(s) {
+		if isBuiltinVariable(name) {
+			continue
+		}
+		if _, err := strconv.Atoi(name); err == nil {
+			continue
+		}
+		found := false
+		for _, v := range variables {
+			if v.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
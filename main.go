@@ -70,6 +70,9 @@ var lintCmd = &cobra.Command{
 		config.Autofix = lintAutofixFlag
 
 		rules := lint.NewRuleSet()
+		if err := rules.Enable(config.Enable...); err != nil {
+			return fmt.Errorf("failed to enable rules: %v", err)
+		}
 		results, err := rules.Lint([]lint.Dashboard{dashboard})
 		if err != nil {
 			return fmt.Errorf("failed to lint dashboard: %v", err)